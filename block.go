@@ -0,0 +1,96 @@
+package main
+
+import (
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+)
+
+// BlockChange is a block set to State at world coordinate.
+type BlockChange struct {
+	Pos   stream.Position
+	State world.BlockState
+}
+
+// chunkOf return chunk which block at pos belongs to.
+func chunkOf(pos stream.Position) world.ChunkPos {
+	return world.ChunkPos{X: pos.X >> 4, Z: pos.Z >> 4}
+}
+
+// SetBlocks change blocks of w in its loop, players who have loaded the chunks
+// are told in the block update phase of tick.
+func (s *server) SetBlocks(w *world.World, changes ...BlockChange) {
+	var pending []BlockChange
+	for _, c := range changes {
+		x, y, z := int(c.Pos.X), int(c.Pos.Y), int(c.Pos.Z)
+		old := w.Block(x, y, z)
+		if old == c.State {
+			continue
+		}
+		if old.ID() != c.State.ID() {
+			w.Chunk(c.Pos.X>>4, c.Pos.Z>>4).RemoveBlockEntity(x&0xF, y, z&0xF)
+		}
+		w.SetBlock(x, y, z, c.State)
+		pending = append(pending, c)
+	}
+
+	t := s.loop(w)
+	t.mu.Lock()
+	t.changes = append(t.changes, pending...)
+	t.mu.Unlock()
+}
+
+// SendToChunk send pkt to players in w who have loaded chunk at pos, except one.
+// It is called in the loop of w.
+func (s *server) SendToChunk(w *world.World, pos world.ChunkPos, pkt packet.Packet, except *Player) {
+	for _, p := range s.loop(w).players {
+		if p != except && p.HasChunk(pos) {
+			p.Send(pkt)
+		}
+	}
+}
+
+// SendToWorld send pkt to all players in w, it is called in the loop of w.
+func (s *server) SendToWorld(w *world.World, pkt packet.Packet) {
+	for _, p := range s.loop(w).players {
+		p.Send(pkt)
+	}
+}
+
+// ResendBlock tell player the real block at pos, after it was changed by client but refused.
+func (player *Player) ResendBlock(pos stream.Position) {
+	state := player.world.Block(int(pos.X), int(pos.Y), int(pos.Z))
+	player.Send(NewBlockChangePacket(pos, state))
+}
+
+func NewBlockChangePacket(pos stream.Position, state world.BlockState) packet.Packet {
+	pkt := packet.NewPacket(0x0B)
+	pkt.WritePosition(pos).WriteVarInt(uint64(state))
+	return pkt
+}
+
+// NewMultiBlockChangePacket build Multi Block Change of changes all in chunk at pos.
+func NewMultiBlockChangePacket(pos world.ChunkPos, changes []BlockChange) packet.Packet {
+	pkt := packet.NewPacket(0x10)
+	pkt.WriteInt(uint32(pos.X)).WriteInt(uint32(pos.Z)).WriteVarInt(uint64(len(changes)))
+	for _, c := range changes {
+		pkt.WriteUByte(byte(c.Pos.X&0xF)<<4 | byte(c.Pos.Z&0xF)).
+			WriteUByte(byte(c.Pos.Y)).
+			WriteVarInt(uint64(c.State))
+	}
+	return pkt
+}
+
+// NewBlockBreakAnimationPacket build Block Break Animation of stage 0 to 9, others remove it.
+func NewBlockBreakAnimationPacket(entityID int32, pos stream.Position, stage int8) packet.Packet {
+	pkt := packet.NewPacket(0x08)
+	pkt.WriteVarInt(uint64(entityID)).WritePosition(pos).WriteUByte(byte(stage))
+	return pkt
+}
+
+// NewEffectPacket build Effect of id at pos, like particles and sound of block break.
+func NewEffectPacket(id int32, pos stream.Position, data int32) packet.Packet {
+	pkt := packet.NewPacket(0x21)
+	pkt.WriteInt(uint32(id)).WritePosition(pos).WriteInt(uint32(data)).WriteBoolean(false)
+	return pkt
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"math"
+	"sync"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/world"
+	log "github.com/sirupsen/logrus"
+)
+
+// ChunkPosOf return chunk which block coordinate x, z belongs to.
+func ChunkPosOf(x, z float64) world.ChunkPos {
+	return world.ChunkPos{X: int32(math.Floor(x / 16)), Z: int32(math.Floor(z / 16))}
+}
+
+// spiral return chunks around center within radius, from the center to outside.
+func spiral(center world.ChunkPos, radius int32) []world.ChunkPos {
+	side := 2*radius + 1
+	chunks := make([]world.ChunkPos, 0, side*side)
+	chunks = append(chunks, center)
+	for r := int32(1); r <= radius; r++ {
+		// start at the top left corner of ring r and walk around clockwise
+		x, z := center.X-r, center.Z-r
+		for _, d := range [4][2]int32{{1, 0}, {0, 1}, {-1, 0}, {0, -1}} {
+			for i := int32(0); i < 2*r; i++ {
+				chunks = append(chunks, world.ChunkPos{X: x, Z: z})
+				x, z = x+d[0], z+d[1]
+			}
+		}
+	}
+	return chunks
+}
+
+// chunkView is the chunks loaded by a player's client.
+type chunkView struct {
+	mu     sync.Mutex
+	loaded map[world.ChunkPos]struct{}
+	// chunks waiting to be sent, nearest first
+	queue []world.ChunkPos
+}
+
+// ViewDistance return radius in chunks that player can see,
+// it is the server's view distance capped by client settings.
+func (player *Player) ViewDistance() int32 {
+	distance := player.server.ViewDistance
+	if player.ClientViewDistance > 0 && player.ClientViewDistance < distance {
+		distance = player.ClientViewDistance
+	}
+	return distance
+}
+
+// UpdateChunks unload chunks out of view distance,
+// and queue chunks newly entered to be sent.
+func (player *Player) UpdateChunks() {
+	view := &player.chunks
+	center := ChunkPosOf(player.PL.X, player.PL.Z)
+	radius := player.ViewDistance()
+
+	view.mu.Lock()
+
+	var unload []world.ChunkPos
+	for pos := range view.loaded {
+		if abs32(pos.X-center.X) > radius || abs32(pos.Z-center.Z) > radius {
+			delete(view.loaded, pos)
+			unload = append(unload, pos)
+		}
+	}
+
+	view.queue = view.queue[:0]
+	for _, pos := range spiral(center, radius) {
+		if _, ok := view.loaded[pos]; !ok {
+			view.queue = append(view.queue, pos)
+		}
+	}
+	view.mu.Unlock()
+
+	for _, pos := range unload {
+		player.Send(NewUnloadChunkPacket(pos))
+	}
+}
+
+// resetChunks forget chunks sent to player, after client dropped them on Respawn.
+func (player *Player) resetChunks() {
+	view := &player.chunks
+	view.mu.Lock()
+	view.loaded = make(map[world.ChunkPos]struct{})
+	view.queue = view.queue[:0]
+	view.mu.Unlock()
+}
+
+// sendQueuedChunks send at most n chunks in queue.
+func (player *Player) sendQueuedChunks(n int) {
+	view := &player.chunks
+
+	view.mu.Lock()
+	if n > len(view.queue) {
+		n = len(view.queue)
+	}
+	send := append([]world.ChunkPos(nil), view.queue[:n]...)
+	view.queue = view.queue[n:]
+	for _, pos := range send {
+		view.loaded[pos] = struct{}{}
+	}
+	view.mu.Unlock()
+
+	for _, pos := range send {
+		player.Send(player.world.Chunk(pos.X, pos.Z).ChunkDataPacket(player.world.HasSkyLight()))
+	}
+}
+
+// sendModifiedSections send sections of w modified without block changes,
+// like light flowing from a new chunk, to players who loaded them.
+func (s *server) sendModifiedSections(w *world.World) {
+	players := s.loop(w).players
+	w.EachModified(func(c *world.Chunk, sections uint16) {
+		pos := world.ChunkPos{X: c.X, Z: c.Z}
+		// most chunks modified are loaded by nobody, build packet only if needed
+		var pkt packet.Packet
+		built := false
+		for _, p := range players {
+			if p.HasChunk(pos) {
+				if !built {
+					pkt, built = c.SectionsPacket(sections, w.HasSkyLight()), true
+				}
+				p.Send(pkt)
+			}
+		}
+	})
+}
+
+// unloadInterval is how often in ticks chunks nobody uses are unloaded.
+const unloadInterval = 30 * TicksPerSecond
+
+// unloadChunks save and forget chunks of the world of t out of view of every player in it,
+// chunks around one more chunk than view distance are kept so that light flows
+// between chunks at the edge of view, and so are chunks of furnaces burning.
+func (s *server) unloadChunks(t *ticker) {
+	keep := make(map[world.ChunkPos]bool)
+	for _, p := range t.players {
+		for _, pos := range spiral(ChunkPosOf(p.PL.X, p.PL.Z), p.ViewDistance()+1) {
+			keep[pos] = true
+		}
+	}
+	for key := range t.containers {
+		keep[chunkOf(key.pos)] = true
+	}
+	n, err := t.world.Unload(func(pos world.ChunkPos) bool { return keep[pos] })
+	if err != nil {
+		log.WithError(err).Errorf("failed to unload chunks of %s", t.world.Name)
+	}
+	log.Debugf("unloaded %d chunks of %s", n, t.world.Name)
+}
+
+func NewUnloadChunkPacket(pos world.ChunkPos) packet.Packet {
+	pkt := packet.NewPacket(0x1D)
+	pkt.WriteInt(uint32(pos.X)).WriteInt(uint32(pos.Z))
+	return pkt
+}
+
+func abs32(a int32) int32 {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// HasChunk report whether chunk at pos has been sent to player.
+func (player *Player) HasChunk(pos world.ChunkPos) bool {
+	view := &player.chunks
+	view.mu.Lock()
+	defer view.mu.Unlock()
+	_, ok := view.loaded[pos]
+	return ok
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/world"
+	log "github.com/sirupsen/logrus"
+)
+
+// HandleChat handle raw input of Chat Message, input starts with / is a command.
+func (player *Player) HandleChat(input string) {
+	if len(input) == 0 {
+		return
+	}
+
+	if input[0] == '/' {
+		log.WithField("player", player.Meta.User).Infof("input command: %s", input)
+		player.runCommand(strings.Split(input[1:], " "))
+		return
+	}
+
+	chatMessage := packet.NewPacket(0x0F)
+	msg := Chat{
+		Text: fmt.Sprintf("[%s]", player.Meta.User),
+		Bold: true,
+		Extra: []Chat{
+			{
+				Text: input,
+				Bold: false,
+			},
+		},
+	}
+	chatMessage.WriteString(msg.String()).WriteRaw([]byte{0})
+	player.Send(chatMessage)
+}
+
+func (player *Player) runCommand(command []string) {
+	switch command[0] {
+	case "tps":
+		player.SendTPS()
+	case "time":
+		player.timeCommand(command[1:])
+	case "weather":
+		player.weatherCommand(command[1:])
+	case "gamerule":
+		player.gameRuleCommand(command[1:])
+	case "world":
+		player.worldCommand(command[1:])
+	case "gamemode":
+		player.gameModeCommand(command[1:])
+	}
+}
+
+// SendError tell player a command failed.
+func (player *Player) SendError(msg string) {
+	player.SendChat(Chat{Text: msg, Color: "red"})
+}
+
+// gameRuleCommand handle /gamerule <rule> [value], it shows the rule without value.
+func (player *Player) gameRuleCommand(args []string) {
+	w := player.world
+	if len(args) == 0 {
+		player.SendError("用法: /gamerule <规则> [值]")
+		return
+	}
+	rule := args[0]
+	if _, ok := world.DefaultGameRules[rule]; !ok {
+		player.SendError(fmt.Sprintf("未知的游戏规则: %s", rule))
+		return
+	}
+	if len(args) == 1 {
+		player.SendChat(Chat{Text: fmt.Sprintf("%s = %s", rule, w.Level().GameRule(rule))})
+		return
+	}
+
+	w.UpdateLevel(func(l *world.Level) {
+		l.SetGameRule(rule, args[1])
+	})
+	if rule == world.GameRuleDoDaylightCycle {
+		player.server.BroadcastTime(w)
+	}
+	player.SendChat(Chat{Text: fmt.Sprintf("游戏规则 %s 已设置为 %s", rule, args[1])})
+}
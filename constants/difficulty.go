@@ -0,0 +1,9 @@
+package constants
+
+// difficulties of Join Game and level.dat
+const (
+	DifficultyPeaceful = 0
+	DifficultyEasy     = 1
+	DifficultyNormal   = 2
+	DifficultyHard     = 3
+)
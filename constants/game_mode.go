@@ -0,0 +1,9 @@
+package constants
+
+// game modes of Join Game and Change Game State
+const (
+	GameModeSurvival  = 0
+	GameModeCreative  = 1
+	GameModeAdventure = 2
+	GameModeSpectator = 3
+)
@@ -0,0 +1,12 @@
+package constants
+
+// PlayerListAction is the action field of Player List Item packet.
+type PlayerListAction uint64
+
+const (
+	PlayerListAddPlayer         PlayerListAction = iota // add player
+	PlayerListUpdateGameMode                            // update gamemode
+	PlayerListUpdateLatency                             // update latency
+	PlayerListUpdateDisplayName                         // update display name
+	PlayerListRemovePlayer                              // remove player
+)
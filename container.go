@@ -0,0 +1,264 @@
+package main
+
+import (
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+	"github.com/laushunyu/real/world/anvil"
+	"github.com/seebs/nbt"
+)
+
+// Container is slots of a window other than player inventory.
+type Container interface {
+	Size() int
+	Slot(i int) stream.Slot
+	SetSlot(i int, it stream.Slot)
+	// CanPlace report whether player can put it into slot i
+	CanPlace(i int, it stream.Slot) bool
+}
+
+// shiftTargeter is Container choosing slots for items shift clicked into it,
+// no slots means the item goes to the other part of player inventory.
+type shiftTargeter interface {
+	ShiftSlots(it stream.Slot) []int
+}
+
+// closer is Container cleaned up when a player closes it.
+type closer interface {
+	Close(p *Player)
+}
+
+// containerKind is a kind of block which opens a window when used.
+type containerKind struct {
+	// Type is window type of Open Window
+	Type  string
+	Title string
+	Size  int
+	// Slots is number of slots told in Open Window, 0 for windows keeping nothing
+	Slots int
+	// Result is the take only slot, -1 if none
+	Result int
+	// BlockEntity keeps items of containers shared by players,
+	// empty if items are given back when closed
+	BlockEntity string
+}
+
+var (
+	chestKind         = &containerKind{Type: "minecraft:chest", Title: "箱子", Size: 27, Slots: 27, Result: -1, BlockEntity: "minecraft:chest"}
+	furnaceKind       = &containerKind{Type: "minecraft:furnace", Title: "熔炉", Size: 3, Slots: 3, Result: 2, BlockEntity: "minecraft:furnace"}
+	craftingTableKind = &containerKind{Type: "minecraft:crafting_table", Title: "工作台", Size: 10, Result: 0}
+)
+
+// containerKinds by block name
+var containerKinds = map[string]*containerKind{
+	"minecraft:chest":          chestKind,
+	"minecraft:trapped_chest":  chestKind,
+	"minecraft:furnace":        furnaceKind,
+	"minecraft:lit_furnace":    furnaceKind,
+	"minecraft:crafting_table": craftingTableKind,
+	"minecraft:anvil":          {Type: "minecraft:anvil", Title: "铁砧", Size: 3, Result: 2},
+}
+
+// gridContainer is slots of a player working on a block, like crafting table,
+// items are given back when closed.
+type gridContainer struct {
+	kind  *containerKind
+	slots []stream.Slot
+}
+
+func newGridContainer(kind *containerKind) *gridContainer {
+	c := &gridContainer{kind: kind, slots: make([]stream.Slot, kind.Size)}
+	for i := range c.slots {
+		c.slots[i] = stream.EmptySlot
+	}
+	return c
+}
+
+func (c *gridContainer) Size() int                          { return len(c.slots) }
+func (c *gridContainer) Slot(i int) stream.Slot             { return c.slots[i] }
+func (c *gridContainer) CanPlace(i int, _ stream.Slot) bool { return i != c.kind.Result }
+
+func (c *gridContainer) SetSlot(i int, it stream.Slot) {
+	if it.Empty() {
+		it = stream.EmptySlot
+	}
+	c.slots[i] = it
+}
+
+// ShiftSlots return slots items are shift clicked into, crafting grid is only filled by hand.
+func (c *gridContainer) ShiftSlots(stream.Slot) []int {
+	if c.kind == craftingTableKind {
+		return nil
+	}
+	slots := make([]int, 0, len(c.slots))
+	for i := range c.slots {
+		if i != c.kind.Result {
+			slots = append(slots, i)
+		}
+	}
+	return slots
+}
+
+func (c *gridContainer) Close(p *Player) {
+	for i, it := range c.slots {
+		if i != c.kind.Result && !it.Empty() {
+			// items can't be dropped yet, the ones not fit are gone
+			p.inventory.Add(it)
+		}
+		c.slots[i] = stream.EmptySlot
+	}
+}
+
+// blockContainer is items kept in block entity, like chests,
+// players viewing it see changes of each other.
+type blockContainer struct {
+	server *server
+	kind   *containerKind
+	world  *world.World
+	pos    stream.Position
+	slots  []stream.Slot
+	// viewers have the container open
+	viewers []*Player
+	// furnace is state of smelting, nil if container is not a furnace
+	furnace *furnace
+}
+
+// containerKey is a block of a world.
+type containerKey struct {
+	w   *world.World
+	pos stream.Position
+}
+
+// openContainer return container of block at pos shared by viewers, items are loaded from block entity.
+func (s *server) openContainer(w *world.World, pos stream.Position, kind *containerKind) *blockContainer {
+	containers := s.loop(w).containers
+	key := containerKey{w, pos}
+	if c, ok := containers[key]; ok {
+		return c
+	}
+	c := &blockContainer{server: s, kind: kind, world: w, pos: pos, slots: make([]stream.Slot, kind.Size)}
+	for i := range c.slots {
+		c.slots[i] = stream.EmptySlot
+	}
+	tag := c.blockEntity()
+	for _, it := range itemList(tag["Items"]) {
+		if slot, ok := it["Slot"].(nbt.Byte); ok && int(slot) >= 0 && int(slot) < len(c.slots) {
+			c.slots[slot] = anvil.DecodeItem(it)
+		}
+	}
+	if kind == furnaceKind {
+		c.furnace = loadFurnace(tag, c.slots[furnaceFuel])
+	}
+	containers[key] = c
+	return c
+}
+
+// itemList return compounds in list tag.
+func itemList(tag nbt.Tag) []nbt.Compound {
+	list, ok := tag.(nbt.List)
+	if !ok {
+		return nil
+	}
+	compounds, _ := list.GetCompoundList()
+	return compounds
+}
+
+func (c *blockContainer) chunk() *world.Chunk {
+	return c.world.Chunk(c.pos.X>>4, c.pos.Z>>4)
+}
+
+func (c *blockContainer) blockEntity() nbt.Compound {
+	return c.chunk().BlockEntity(int(c.pos.X)&0xF, int(c.pos.Y), int(c.pos.Z)&0xF)
+}
+
+func (c *blockContainer) Size() int              { return len(c.slots) }
+func (c *blockContainer) Slot(i int) stream.Slot { return c.slots[i] }
+func (c *blockContainer) CanPlace(i int, it stream.Slot) bool {
+	if c.furnace != nil && i == furnaceFuel {
+		return isFuel(it)
+	}
+	return i != c.kind.Result
+}
+
+// ShiftSlots return slots items are shift clicked into, furnaces take things to smelt and fuels.
+func (c *blockContainer) ShiftSlots(it stream.Slot) []int {
+	if c.furnace != nil {
+		return furnaceSlots(it)
+	}
+	return slotRange(0, len(c.slots)-1)
+}
+
+// SetSlot set item, save it in block entity and tell all viewers.
+func (c *blockContainer) SetSlot(i int, it stream.Slot) {
+	if it.Empty() {
+		it = stream.EmptySlot
+	}
+	c.slots[i] = it
+	c.save()
+	for _, p := range c.viewers {
+		p.Send(NewSetSlotPacket(int8(p.window.ID), int16(i), it))
+	}
+}
+
+// save write items into block entity, other tags of it are kept.
+func (c *blockContainer) save() {
+	tag := nbt.Compound{"id": nbt.String(c.kind.BlockEntity)}
+	for k, v := range c.blockEntity() {
+		tag[k] = v
+	}
+	items := make([]nbt.Compound, 0, len(c.slots))
+	for i, it := range c.slots {
+		if !it.Empty() {
+			t := anvil.EncodeItem(it)
+			t["Slot"] = nbt.Byte(i)
+			items = append(items, t)
+		}
+	}
+	tag["Items"] = nbt.MakeCompoundList(items)
+	if c.furnace != nil {
+		c.furnace.write(tag)
+	}
+	c.chunk().SetBlockEntity(int(c.pos.X)&0xF, int(c.pos.Y), int(c.pos.Z)&0xF, tag)
+}
+
+// Open add p as a viewer, chests are opened for everyone nearby.
+func (c *blockContainer) Open(p *Player) {
+	c.viewers = append(c.viewers, p)
+	c.sendViewers()
+	if c.furnace != nil {
+		for _, pkt := range c.furnace.properties(p.window.ID) {
+			p.Send(pkt)
+		}
+	}
+}
+
+// Close remove p from viewers, container is forgotten once nobody views it
+// unless it is a furnace burning.
+func (c *blockContainer) Close(p *Player) {
+	for i, v := range c.viewers {
+		if v == p {
+			c.viewers = append(c.viewers[:i], c.viewers[i+1:]...)
+			break
+		}
+	}
+	if len(c.viewers) == 0 && !c.furnace.burning() {
+		delete(c.server.loop(c.world).containers, containerKey{c.world, c.pos})
+	}
+	c.sendViewers()
+}
+
+// sendViewers open or close lid of chest by number of viewers.
+func (c *blockContainer) sendViewers() {
+	if c.kind != chestKind {
+		return
+	}
+	state := c.world.Block(int(c.pos.X), int(c.pos.Y), int(c.pos.Z))
+	c.server.SendToChunk(c.world, chunkOf(c.pos), NewBlockActionPacket(c.pos, 1, byte(len(c.viewers)), state.ID()), nil)
+}
+
+// NewBlockActionPacket build Block Action, like chest lid with param of viewers.
+func NewBlockActionPacket(pos stream.Position, action, param byte, block uint16) packet.Packet {
+	pkt := packet.NewPacket(0x0A)
+	pkt.WritePosition(pos).WriteUByte(action).WriteUByte(param).WriteVarInt(uint64(block))
+	return pkt
+}
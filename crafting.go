@@ -0,0 +1,301 @@
+package main
+
+import (
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/recipe"
+	"github.com/laushunyu/real/stream"
+)
+
+// actions of Unlock Recipes
+const (
+	UnlockRecipesInit = iota
+	UnlockRecipesAdd
+	UnlockRecipesRemove
+)
+
+// types of Crafting Book Data
+const (
+	CraftingBookDisplayed = iota
+	CraftingBookStatus
+)
+
+// RecipeBook is state of recipe book of player.
+type RecipeBook struct {
+	Open, Filtering bool
+}
+
+// NewUnlockRecipesPacket build Unlock Recipes, display are recipes shown as new, only used by init.
+func NewUnlockRecipesPacket(action int, book RecipeBook, ids, display []int32) packet.Packet {
+	pkt := packet.NewPacket(0x31)
+	pkt.WriteVarInt(uint64(action)).WriteBoolean(book.Open).WriteBoolean(book.Filtering)
+	pkt.WriteVarInt(uint64(len(ids)))
+	for _, id := range ids {
+		pkt.WriteVarInt(uint64(id))
+	}
+	if action == UnlockRecipesInit {
+		pkt.WriteVarInt(uint64(len(display)))
+		for _, id := range display {
+			pkt.WriteVarInt(uint64(id))
+		}
+	}
+	return pkt
+}
+
+// NewCraftRecipeResponsePacket build Craft Recipe Response,
+// client shows the recipe in grid as a ghost since items are not enough.
+func NewCraftRecipeResponsePacket(window byte, id int32) packet.Packet {
+	pkt := packet.NewPacket(0x2B)
+	pkt.WriteUByte(window).WriteVarInt(uint64(id))
+	return pkt
+}
+
+// SendRecipes unlock all recipes in recipe book of player.
+func (player *Player) SendRecipes() {
+	recipes := recipe.All()
+	ids := make([]int32, len(recipes))
+	for i, r := range recipes {
+		ids[i] = r.ID
+	}
+	player.Send(NewUnlockRecipesPacket(UnlockRecipesInit, player.recipeBook, ids, nil))
+}
+
+// grid return first slot and size of crafting grid in window, size is 0 if there is no grid.
+func (w *Window) grid() (start, size int) {
+	switch {
+	case w.container == nil:
+		return SlotCraftStart, 2
+	case w.kind == craftingTableKind:
+		return 1, 3
+	}
+	return 0, 0
+}
+
+// gridItems return items in crafting grid of window row by row.
+func (w *Window) gridItems() []stream.Slot {
+	start, size := w.grid()
+	items := make([]stream.Slot, size*size)
+	for i := range items {
+		items[i] = w.Slot(start + i)
+	}
+	return items
+}
+
+// updateCrafting set the result of items in crafting grid, it reports whether window has a grid.
+// Client doesn't craft, the result slot is sent to it.
+func (w *Window) updateCrafting() bool {
+	_, size := w.grid()
+	if size == 0 {
+		return false
+	}
+	result := stream.EmptySlot
+	if r := recipe.Match(w.gridItems(), size); r != nil {
+		result = r.Result
+	}
+	w.SetSlot(SlotCraftResult, result)
+	return true
+}
+
+// sendResult send result slot of window if it crafts.
+func (player *Player) sendResult(w *Window) {
+	if w.updateCrafting() {
+		player.Send(NewSetSlotPacket(int8(w.ID), SlotCraftResult, w.Slot(SlotCraftResult)))
+	}
+}
+
+// consumeGrid take one of each item in crafting grid after result is taken,
+// things like empty buckets are left in grid.
+func (player *Player) consumeGrid(w *Window) {
+	start, size := w.grid()
+	for i := start; i < start+size*size; i++ {
+		it := w.Slot(i)
+		if it.Empty() {
+			continue
+		}
+		left := recipe.Remainder(it)
+		it.Count--
+		if it.Empty() {
+			it = left
+		} else if !left.Empty() {
+			// items can't be dropped yet, the ones not fit are gone
+			player.inventory.Add(left)
+		}
+		w.SetSlot(i, it)
+	}
+	w.updateCrafting()
+}
+
+// takeResult take crafted items out of result slot by click of mode, it returns
+// the clicked item and false if the click is not about crafting but done as usual.
+func (player *Player) takeResult(w *Window, mode, button int) (stream.Slot, bool) {
+	inv := player.inventory
+	result := w.Slot(SlotCraftResult)
+	switch mode {
+	case ClickNormal:
+		if result.Empty() || button > 1 {
+			return stream.EmptySlot, false
+		}
+		cursor := result
+		if !inv.Cursor.Empty() {
+			if !Stackable(inv.Cursor, result) || int(inv.Cursor.Count+result.Count) > MaxStack(result) {
+				return result, true
+			}
+			cursor.Count += inv.Cursor.Count
+		}
+		inv.Cursor = cursor
+		player.consumeGrid(w)
+		return result, true
+	case ClickShift:
+		// craft until grid runs out or inventory is full
+		crafted := false
+		targets := w.shiftTargets(SlotCraftResult, result)
+		for !result.Empty() && w.room(result, targets) >= int(result.Count) {
+			w.moveInto(result, targets)
+			player.consumeGrid(w)
+			crafted = true
+			if !sameItem(w.Slot(SlotCraftResult), result) {
+				break
+			}
+		}
+		if !crafted {
+			return stream.EmptySlot, true
+		}
+		return result, true
+	case ClickNumberKey:
+		if button < 0 || button > 8 {
+			return stream.EmptySlot, false
+		}
+		if hotbar := w.hotbarSlot(button); !result.Empty() && w.Slot(hotbar).Empty() {
+			w.SetSlot(hotbar, result)
+			player.consumeGrid(w)
+		}
+		return stream.EmptySlot, true
+	case ClickDrop:
+		// item entities are not spawned yet, crafted items dropped are gone
+		if !result.Empty() {
+			player.consumeGrid(w)
+		}
+		return stream.EmptySlot, true
+	}
+	return stream.EmptySlot, false
+}
+
+// room return how many of it can be put into slots.
+func (w *Window) room(it stream.Slot, slots []int) int {
+	n, max := 0, MaxStack(it)
+	for _, i := range slots {
+		s := w.Slot(i)
+		switch {
+		case !w.CanPlace(i, it):
+		case s.Empty():
+			n += max
+		case Stackable(s, it):
+			n += max - int(s.Count)
+		}
+	}
+	return n
+}
+
+// HandleCraftRecipeRequest handle recipe chosen in recipe book, items in grid go back to inventory
+// and ingredients of one craft, or as many as possible if makeAll, are moved into grid.
+func (player *Player) HandleCraftRecipeRequest(id byte, recipeID int32, makeAll bool) {
+	w := player.openWindow()
+	start, size := w.grid()
+	r := recipe.ByID(recipeID)
+	if id != w.ID || size == 0 || r == nil {
+		return
+	}
+	if r.Width > size || r.Height > size || len(r.Ingredients) > size*size {
+		return
+	}
+
+	inv := player.inventory
+	for i := start; i < start+size*size; i++ {
+		if it := w.Slot(i); !it.Empty() {
+			left := inv.Add(it)
+			w.SetSlot(i, left)
+			if !left.Empty() {
+				player.syncWindow(w)
+				return
+			}
+		}
+	}
+
+	// cells of grid for each ingredient
+	cells := make([]int, len(r.Ingredients))
+	for i := range r.Ingredients {
+		cells[i] = i
+		if !r.Shapeless {
+			cells[i] = i/r.Width*size + i%r.Width
+		}
+	}
+	times := 1
+	if makeAll {
+		times = MaxStack(r.Result)
+	}
+	crafted := 0
+	for crafted < times && player.fillGrid(w, r, cells) {
+		crafted++
+	}
+	if crafted == 0 {
+		player.Send(NewCraftRecipeResponsePacket(w.ID, r.ID))
+	}
+	w.updateCrafting()
+	player.syncWindow(w)
+}
+
+// fillGrid move ingredients of one craft of r from inventory into cells of grid,
+// nothing is moved and it reports false if any of them is missing.
+func (player *Player) fillGrid(w *Window, r *recipe.Recipe, cells []int) bool {
+	inv := player.inventory
+	start, size := w.grid()
+	items := inv.Slots()
+	grid := w.gridItems()
+	for i, ing := range r.Ingredients {
+		if len(ing) == 0 {
+			continue
+		}
+		cell := grid[cells[i]]
+		if !cell.Empty() && int(cell.Count) >= MaxStack(cell) {
+			return false
+		}
+		found := false
+		for slot := SlotMainStart; slot <= SlotHotbarEnd; slot++ {
+			it := items[slot]
+			if !ing.Match(it) || !cell.Empty() && !Stackable(cell, it) {
+				continue
+			}
+			if cell.Empty() {
+				cell = it
+				cell.Count = 0
+			}
+			cell.Count++
+			it.Count--
+			if it.Empty() {
+				it = stream.EmptySlot
+			}
+			items[slot] = it
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+		grid[cells[i]] = cell
+	}
+
+	for slot := SlotMainStart; slot <= SlotHotbarEnd; slot++ {
+		inv.SetSlot(slot, items[slot])
+	}
+	for i := 0; i < size*size; i++ {
+		w.SetSlot(start+i, grid[i])
+	}
+	return true
+}
+
+// HandleCraftingBookData handle Crafting Book Data, only whether recipe book is open
+// and filtering craftable are kept.
+func (player *Player) HandleCraftingBookData(typ int, open, filtering bool) {
+	if typ == CraftingBookStatus {
+		player.recipeBook = RecipeBook{Open: open, Filtering: filtering}
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+)
+
+// status of Player Digging
+const (
+	DigStart      = 0
+	DigCancel     = 1
+	DigFinish     = 2
+	DigDropStack  = 3
+	DigDropItem   = 4
+	DigReleaseUse = 5
+	DigSwapHands  = 6
+)
+
+// EffectBlockBreak is Effect id of particles and sound of a broken block.
+const EffectBlockBreak = 2001
+
+// MaxReach is how far from eyes in blocks a player can dig or place blocks.
+const MaxReach = 6
+
+// digTolerance is the least part of break time a client must dig,
+// clients may finish a bit earlier as they count ticks themselves.
+const digTolerance = 0.7
+
+// digging is the block a survival player is breaking.
+type digging struct {
+	mu     sync.Mutex
+	active bool
+	pos    stream.Position
+	start  time.Time
+	ticks  int
+	// last break animation stage sent
+	stage int8
+}
+
+// plants and attachments which break with the block under them
+var needSupport = map[string]bool{
+	"minecraft:sapling":                       true,
+	"minecraft:tallgrass":                     true,
+	"minecraft:deadbush":                      true,
+	"minecraft:yellow_flower":                 true,
+	"minecraft:red_flower":                    true,
+	"minecraft:double_plant":                  true,
+	"minecraft:brown_mushroom":                true,
+	"minecraft:red_mushroom":                  true,
+	"minecraft:wheat":                         true,
+	"minecraft:carrots":                       true,
+	"minecraft:potatoes":                      true,
+	"minecraft:beetroots":                     true,
+	"minecraft:reeds":                         true,
+	"minecraft:cactus":                        true,
+	"minecraft:snow_layer":                    true,
+	"minecraft:carpet":                        true,
+	"minecraft:redstone_wire":                 true,
+	"minecraft:rail":                          true,
+	"minecraft:golden_rail":                   true,
+	"minecraft:detector_rail":                 true,
+	"minecraft:activator_rail":                true,
+	"minecraft:stone_pressure_plate":          true,
+	"minecraft:wooden_pressure_plate":         true,
+	"minecraft:light_weighted_pressure_plate": true,
+	"minecraft:heavy_weighted_pressure_plate": true,
+	"minecraft:standing_sign":                 true,
+	"minecraft:standing_banner":               true,
+	"minecraft:flower_pot":                    true,
+	"minecraft:unpowered_repeater":            true,
+	"minecraft:powered_repeater":              true,
+	"minecraft:unpowered_comparator":          true,
+	"minecraft:powered_comparator":            true,
+}
+
+// property return value of property name of state, empty if it has no such property.
+func property(state world.BlockState, name string) string {
+	for _, p := range state.Properties() {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// fallsWithout report whether state breaks when the block under it is removed.
+func fallsWithout(state world.BlockState) bool {
+	b := state.Block()
+	if b == nil {
+		return false
+	}
+	if strings.HasSuffix(b.Name, "torch") {
+		return property(state, "facing") == "up"
+	}
+	return needSupport[b.Name]
+}
+
+func (player *Player) eyePosition() world.Vec3 {
+	return world.Vec3{X: player.PL.X, Y: player.PL.Y + 1.62, Z: player.PL.Z}
+}
+
+// canReach report whether block at pos is in height of world and its center is within MaxReach from eyes.
+// Positions out of reach are checked before the world is touched, chunks far away are never loaded for them.
+func (player *Player) canReach(pos stream.Position) bool {
+	if pos.Y < 0 || pos.Y >= world.SectionCount*16 {
+		return false
+	}
+	eye := player.eyePosition()
+	dx := float64(pos.X) + 0.5 - eye.X
+	dy := float64(pos.Y) + 0.5 - eye.Y
+	dz := float64(pos.Z) + 0.5 - eye.Z
+	return dx*dx+dy*dy+dz*dz <= MaxReach*MaxReach
+}
+
+// HandleDigging handle Player Digging of status at block pos.
+func (player *Player) HandleDigging(status int, pos stream.Position) {
+	w := player.world
+	switch status {
+	case DigStart:
+		if !player.canReach(pos) {
+			return
+		}
+		if !player.CanBuild() {
+			player.ResendBlock(pos)
+			return
+		}
+		state := w.Block(int(pos.X), int(pos.Y), int(pos.Z))
+		if state == world.Air {
+			return
+		}
+		if player.abilities.InstantBreak {
+			player.BreakBlock(pos)
+			return
+		}
+
+		ticks := item.BreakTicks(state, player.HeldItem(0))
+		switch {
+		case ticks < 0:
+			player.ResendBlock(pos)
+			return
+		case ticks == 0:
+			player.BreakBlock(pos)
+			return
+		}
+		player.stopDigging()
+		d := &player.digging
+		d.mu.Lock()
+		d.active, d.pos, d.start, d.ticks, d.stage = true, pos, time.Now(), ticks, 0
+		d.mu.Unlock()
+		player.server.SendToChunk(w, chunkOf(pos), NewBlockBreakAnimationPacket(player.ID(), pos, 0), player)
+	case DigCancel:
+		player.stopDigging()
+	case DigFinish:
+		d := &player.digging
+		d.mu.Lock()
+		least := time.Duration(float64(d.ticks) * digTolerance * float64(TickDuration))
+		ok := d.active && d.pos == pos && time.Since(d.start) >= least
+		d.mu.Unlock()
+		player.stopDigging()
+		switch {
+		case ok:
+			player.BreakBlock(pos)
+		case player.canReach(pos):
+			player.ResendBlock(pos)
+		}
+	}
+}
+
+// stopDigging forget the block being dug and remove its break animation.
+func (player *Player) stopDigging() {
+	d := &player.digging
+	d.mu.Lock()
+	active, pos := d.active, d.pos
+	d.active = false
+	d.mu.Unlock()
+	if active {
+		player.server.SendToChunk(player.world, chunkOf(pos), NewBlockBreakAnimationPacket(player.ID(), pos, -1), player)
+	}
+}
+
+// tickDigging tell others when digging progress reaches the next stage.
+func (player *Player) tickDigging() {
+	d := &player.digging
+	d.mu.Lock()
+	if !d.active {
+		d.mu.Unlock()
+		return
+	}
+	stage := int8(time.Since(d.start) * 10 / (time.Duration(d.ticks) * TickDuration))
+	if stage > 9 {
+		stage = 9
+	}
+	changed := stage != d.stage
+	d.stage = stage
+	pos := d.pos
+	d.mu.Unlock()
+
+	if changed {
+		player.server.SendToChunk(player.world, chunkOf(pos), NewBlockBreakAnimationPacket(player.ID(), pos, stage), player)
+	}
+}
+
+// BreakBlock break block at pos by player, with the other half of doors
+// and tall plants, and plants standing on it.
+func (player *Player) BreakBlock(pos stream.Position) {
+	w := player.world
+	state := w.Block(int(pos.X), int(pos.Y), int(pos.Z))
+	if state == world.Air {
+		return
+	}
+
+	changes := []BlockChange{{Pos: pos, State: world.Air}}
+	top := pos
+	switch property(state, "half") {
+	case "upper":
+		below := stream.Position{X: pos.X, Y: pos.Y - 1, Z: pos.Z}
+		if w.Block(int(below.X), int(below.Y), int(below.Z)).ID() == state.ID() {
+			changes = append(changes, BlockChange{Pos: below, State: world.Air})
+		}
+	case "lower":
+		above := stream.Position{X: pos.X, Y: pos.Y + 1, Z: pos.Z}
+		if w.Block(int(above.X), int(above.Y), int(above.Z)).ID() == state.ID() {
+			changes = append(changes, BlockChange{Pos: above, State: world.Air})
+			top = above
+		}
+	}
+	for y := top.Y + 1; y < world.SectionCount*16; y++ {
+		above := stream.Position{X: pos.X, Y: y, Z: pos.Z}
+		if !fallsWithout(w.Block(int(above.X), int(above.Y), int(above.Z))) {
+			break
+		}
+		changes = append(changes, BlockChange{Pos: above, State: world.Air})
+	}
+
+	player.server.SetBlocks(w, changes...)
+	player.exhaust(ExhaustBreakBlock)
+	// the digger plays particles itself
+	data := int32(state.ID()) | int32(state.Meta())<<12
+	player.server.SendToChunk(w, chunkOf(pos), NewEffectPacket(EffectBlockBreak, pos, data), player)
+}
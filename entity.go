@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+)
+
+// size of player's bounding box
+const (
+	PlayerWidth          = 0.6
+	PlayerHeight         = 1.8
+	PlayerSneakingHeight = 1.65
+)
+
+var _ world.Entity = (*Player)(nil)
+
+func (player *Player) ID() int32 {
+	return player.entityID
+}
+
+func (player *Player) UUID() uuid.UUID {
+	return player.Meta.UserID
+}
+
+func (player *Player) Position() world.Vec3 {
+	return world.Vec3{X: player.PL.X, Y: player.PL.Y, Z: player.PL.Z}
+}
+
+func (player *Player) Velocity() world.Vec3 {
+	return player.Vel
+}
+
+func (player *Player) BoundingBox() world.AABB {
+	height := PlayerHeight
+	if world.HasEntityFlag(player.meta, world.EntityFlagCrouched) {
+		height = PlayerSneakingHeight
+	}
+	return world.NewAABB(player.Position(), PlayerWidth, height)
+}
+
+func (player *Player) Metadata() *stream.Metadata {
+	return player.meta
+}
+
+// World return world player is in, it can be called out of the loop of the world.
+func (player *Player) World() *world.World {
+	player.worldMu.RLock()
+	defer player.worldMu.RUnlock()
+	return player.world
+}
+
+// FlushMetadata send changed metadata to player itself and whom can see it.
+func (player *Player) FlushMetadata() {
+	if !player.meta.Dirty() {
+		return
+	}
+	pkt := NewEntityMetadataPacket(player.ID(), player.meta)
+	player.Send(pkt)
+	player.server.tracker.SendToWatchers(player, pkt)
+}
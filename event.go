@@ -1,8 +1,9 @@
 package main
 
 import (
-	"fmt"
 	"reflect"
+
+	log "github.com/sirupsen/logrus"
 )
 
 type EventChunkChange struct {
@@ -58,27 +59,8 @@ var ec = &EventCenter{
 
 func init() {
 	ec.On(func(evt EventChunkChange) {
-		evt.player.SendChat(Chat{
-			Text: fmt.Sprintf("[%s]", evt.player.Meta.User),
-			Bold: true,
-			Extra: []Chat{
-				{
-					Text: " Move to from chunk",
-					Bold: false,
-				},
-				{
-					Text: fmt.Sprintf(" (%d, %d) ", evt.SrcX, evt.SrcZ),
-					Bold: true,
-				},
-				{
-					Text: "to chunk",
-					Bold: false,
-				},
-				{
-					Text: fmt.Sprintf("(%d, %d)", evt.DstX, evt.DstZ),
-					Bold: true,
-				},
-			},
-		})
+		log.WithField("player", evt.player.Meta.User).
+			Debugf("move from chunk (%d, %d) to chunk (%d, %d)", evt.SrcX, evt.SrcZ, evt.DstX, evt.DstZ)
+		evt.player.UpdateChunks()
 	})
 }
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/recipe"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+	"github.com/seebs/nbt"
+)
+
+// slots of furnace
+const (
+	furnaceInput = iota
+	furnaceFuel
+	furnaceOutput
+)
+
+// cookTicks is how long an item is smelted.
+const cookTicks = 200
+
+// properties of furnace window
+const (
+	FurnaceFireIcon = iota
+	FurnaceMaxBurnTime
+	FurnaceProgress
+	FurnaceMaxProgress
+)
+
+// furnace is smelting state of a furnace, in ticks.
+type furnace struct {
+	burnTime, burnTotal int
+	cookTime, cookTotal int
+}
+
+// loadFurnace read state from block entity, burn total is not saved but known by fuel.
+func loadFurnace(tag nbt.Compound, fuel stream.Slot) *furnace {
+	burnTime, _ := tag["BurnTime"].(nbt.Short)
+	cookTime, _ := tag["CookTime"].(nbt.Short)
+	cookTotal, _ := tag["CookTimeTotal"].(nbt.Short)
+	f := &furnace{burnTime: int(burnTime), burnTotal: recipe.FuelTicks(fuel), cookTime: int(cookTime), cookTotal: int(cookTotal)}
+	if f.cookTotal <= 0 {
+		f.cookTotal = cookTicks
+	}
+	return f
+}
+
+func (f *furnace) write(tag nbt.Compound) {
+	tag["BurnTime"] = nbt.Short(f.burnTime)
+	tag["CookTime"] = nbt.Short(f.cookTime)
+	tag["CookTimeTotal"] = nbt.Short(f.cookTotal)
+}
+
+// burning report whether furnace has fuel burning, false for nil.
+func (f *furnace) burning() bool {
+	return f != nil && f.burnTime > 0
+}
+
+// NewWindowPropertyPacket build Window Property, like progress of furnace.
+func NewWindowPropertyPacket(window byte, property, value int16) packet.Packet {
+	pkt := packet.NewPacket(0x15)
+	pkt.WriteUByte(window).WriteShort(uint16(property)).WriteShort(uint16(value))
+	return pkt
+}
+
+// properties return all properties of furnace window.
+func (f *furnace) properties(window byte) []packet.Packet {
+	return []packet.Packet{
+		NewWindowPropertyPacket(window, FurnaceFireIcon, int16(f.burnTime)),
+		NewWindowPropertyPacket(window, FurnaceMaxBurnTime, int16(f.burnTotal)),
+		NewWindowPropertyPacket(window, FurnaceProgress, int16(f.cookTime)),
+		NewWindowPropertyPacket(window, FurnaceMaxProgress, int16(f.cookTotal)),
+	}
+}
+
+// isFuel report whether it can be put in fuel slot, empty buckets are allowed like vanilla.
+func isFuel(it stream.Slot) bool {
+	return recipe.FuelTicks(it) > 0 || uint16(it.ID) == item.Bucket
+}
+
+// furnaceSlots return slots of furnace it is shift clicked into.
+func furnaceSlots(it stream.Slot) []int {
+	switch {
+	case recipe.Smelt(it) != nil:
+		return []int{furnaceInput}
+	case isFuel(it):
+		return []int{furnaceFuel}
+	}
+	return nil
+}
+
+// smelting return recipe of input if its result fits in output slot.
+func (c *blockContainer) smelting() *recipe.Smelting {
+	r := recipe.Smelt(c.slots[furnaceInput])
+	if r == nil {
+		return nil
+	}
+	out := c.slots[furnaceOutput]
+	if !out.Empty() && (!Stackable(out, r.Result) || int(out.Count+r.Result.Count) > MaxStack(out)) {
+		return nil
+	}
+	return r
+}
+
+// tickFurnace burn fuel and smelt like vanilla, the block is lit while burning.
+func (c *blockContainer) tickFurnace() {
+	f := c.furnace
+	before := *f
+	wasBurning := f.burning()
+	if f.burning() {
+		f.burnTime--
+	}
+
+	fuel, input := c.slots[furnaceFuel], c.slots[furnaceInput]
+	if f.burning() || !fuel.Empty() && !input.Empty() {
+		r := c.smelting()
+		if !f.burning() && r != nil {
+			if ticks := recipe.FuelTicks(fuel); ticks > 0 {
+				f.burnTime, f.burnTotal = ticks, ticks
+				left := recipe.Remainder(fuel)
+				if fuel.Count--; fuel.Empty() {
+					fuel = left
+				}
+				c.SetSlot(furnaceFuel, fuel)
+			}
+		}
+		if f.burning() && r != nil {
+			if f.cookTime++; f.cookTime >= f.cookTotal {
+				f.cookTime, f.cookTotal = 0, cookTicks
+				out := c.slots[furnaceOutput]
+				if out.Empty() {
+					out = r.Result
+				} else {
+					out.Count += r.Result.Count
+				}
+				c.SetSlot(furnaceOutput, out)
+				input.Count--
+				c.SetSlot(furnaceInput, input)
+			}
+		} else {
+			f.cookTime = 0
+		}
+	} else if f.cookTime > 0 {
+		// cooling down without fuel
+		f.cookTime -= 2
+		if f.cookTime < 0 {
+			f.cookTime = 0
+		}
+	}
+
+	if *f == before {
+		return
+	}
+	if wasBurning != f.burning() {
+		c.light(f.burning())
+	}
+	c.save()
+	for _, p := range c.viewers {
+		for _, pkt := range f.properties(p.window.ID) {
+			p.Send(pkt)
+		}
+	}
+}
+
+// light swap furnace and lit furnace facing the same way, block entity is kept.
+func (c *blockContainer) light(lit bool) {
+	id := world.BlockFurnace
+	if lit {
+		id = world.BlockLitFurnace
+	}
+	state := c.world.Block(int(c.pos.X), int(c.pos.Y), int(c.pos.Z))
+	c.server.SetBlocks(c.world, BlockChange{Pos: c.pos, State: world.NewBlockState(id, state.Meta())})
+}
+
+// loadFurnaces add furnaces in chunks just loaded to containers so that they keep smelting,
+// the ones with nothing to do are forgotten after a tick.
+func (s *server) loadFurnaces(w *world.World) {
+	for _, chunk := range w.TakeLoaded() {
+		for _, tag := range chunk.BlockEntities() {
+			if id, _ := tag["id"].(nbt.String); id != nbt.String(furnaceKind.BlockEntity) {
+				continue
+			}
+			x, _ := tag["x"].(nbt.Int)
+			y, _ := tag["y"].(nbt.Int)
+			z, _ := tag["z"].(nbt.Int)
+			s.openContainer(w, stream.Position{X: int32(x), Y: int32(y), Z: int32(z)}, furnaceKind)
+		}
+	}
+}
+
+// tickContainers smelt in furnaces, containers of blocks gone are forgotten
+// once their viewers closed them.
+func (s *server) tickContainers(t *ticker) {
+	s.loadFurnaces(t.world)
+	for key, c := range t.containers {
+		b := c.world.Block(int(c.pos.X), int(c.pos.Y), int(c.pos.Z)).Block()
+		if b == nil || containerKinds[b.Name] != c.kind {
+			if len(c.viewers) == 0 {
+				delete(t.containers, key)
+			}
+			continue
+		}
+		if c.furnace != nil {
+			c.tickFurnace()
+		}
+		if len(c.viewers) == 0 && !c.furnace.burning() {
+			delete(t.containers, key)
+		}
+	}
+}
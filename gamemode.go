@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/laushunyu/real/constants"
+	"github.com/laushunyu/real/packet"
+)
+
+// flags of Player Abilities
+const (
+	AbilityInvulnerable = 0x01
+	AbilityFlying       = 0x02
+	AbilityAllowFlying  = 0x04
+	AbilityInstantBreak = 0x08
+)
+
+// default speeds of Player Abilities
+const (
+	DefaultFlySpeed  = 0.05
+	DefaultWalkSpeed = 0.1
+)
+
+// Abilities is what a player can do, mostly decided by its game mode.
+type Abilities struct {
+	Invulnerable, Flying, AllowFlying, InstantBreak bool
+	FlySpeed, WalkSpeed                             float32
+}
+
+// AbilitiesOf return abilities of game mode like vanilla.
+func AbilitiesOf(mode byte) Abilities {
+	a := Abilities{FlySpeed: DefaultFlySpeed, WalkSpeed: DefaultWalkSpeed}
+	switch mode {
+	case constants.GameModeCreative:
+		a.Invulnerable, a.AllowFlying, a.InstantBreak = true, true, true
+	case constants.GameModeSpectator:
+		a.Invulnerable, a.AllowFlying, a.Flying = true, true, true
+	}
+	return a
+}
+
+// Flags return abilities as flags of Player Abilities.
+func (a Abilities) Flags() byte {
+	var flags byte
+	if a.Invulnerable {
+		flags |= AbilityInvulnerable
+	}
+	if a.Flying {
+		flags |= AbilityFlying
+	}
+	if a.AllowFlying {
+		flags |= AbilityAllowFlying
+	}
+	if a.InstantBreak {
+		flags |= AbilityInstantBreak
+	}
+	return flags
+}
+
+// NewPlayerAbilitiesPacket build clientbound Player Abilities, FOV modifier is the walk speed.
+func NewPlayerAbilitiesPacket(a Abilities) packet.Packet {
+	pkt := packet.NewPacket(0x2C)
+	pkt.WriteUByte(a.Flags()).WriteFloat(a.FlySpeed).WriteFloat(a.WalkSpeed)
+	return pkt
+}
+
+// names of game modes in commands, numbers are accepted too
+var gameModeNames = map[string]byte{
+	"survival":  constants.GameModeSurvival,
+	"s":         constants.GameModeSurvival,
+	"0":         constants.GameModeSurvival,
+	"creative":  constants.GameModeCreative,
+	"c":         constants.GameModeCreative,
+	"1":         constants.GameModeCreative,
+	"adventure": constants.GameModeAdventure,
+	"a":         constants.GameModeAdventure,
+	"2":         constants.GameModeAdventure,
+	"spectator": constants.GameModeSpectator,
+	"sp":        constants.GameModeSpectator,
+	"3":         constants.GameModeSpectator,
+}
+
+// display names of game modes
+var gameModeTitles = [...]string{"生存模式", "创造模式", "冒险模式", "旁观模式"}
+
+// CanBuild report whether player can break and place blocks,
+// adventure and spectator players can not.
+func (player *Player) CanBuild() bool {
+	return player.GameMode == constants.GameModeSurvival || player.GameMode == constants.GameModeCreative
+}
+
+// setGameMode change game mode and abilities without telling anyone,
+// it is used before player joined.
+func (player *Player) setGameMode(mode byte) {
+	player.GameMode = mode
+	player.abilities = AbilitiesOf(mode)
+}
+
+// SetGameMode change game mode of player, tell the client and others in tab list.
+func (player *Player) SetGameMode(mode byte) {
+	if mode == player.GameMode {
+		return
+	}
+	player.stopDigging()
+	player.setGameMode(mode)
+	player.Send(NewChangeGameStatePacket(GameStateChangeMode, float32(mode)))
+	player.Send(NewPlayerAbilitiesPacket(player.abilities))
+	player.server.Broadcast(NewPlayerListItemPacket(constants.PlayerListUpdateGameMode, player))
+}
+
+// HandleAbilities handle serverbound Player Abilities, client can only start
+// or stop flying, and only if flying is allowed.
+func (player *Player) HandleAbilities(flags byte) {
+	flying := flags&AbilityFlying != 0
+	if flying && !player.abilities.AllowFlying {
+		// tell client it is not flying
+		player.Send(NewPlayerAbilitiesPacket(player.abilities))
+		return
+	}
+	player.abilities.Flying = flying
+}
+
+// gameModeCommand handle /gamemode <mode>.
+func (player *Player) gameModeCommand(args []string) {
+	if len(args) == 0 {
+		player.SendError("用法: /gamemode <survival|creative|adventure|spectator>")
+		return
+	}
+	mode, ok := gameModeNames[strings.ToLower(args[0])]
+	if !ok {
+		player.SendError(fmt.Sprintf("未知的游戏模式: %s", args[0]))
+		return
+	}
+	player.SetGameMode(mode)
+	player.SendChat(Chat{Text: fmt.Sprintf("游戏模式已设置为%s", gameModeTitles[mode])})
+}
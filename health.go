@@ -0,0 +1,389 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/laushunyu/real/constants"
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/world"
+)
+
+// limits of player status like vanilla
+const (
+	MaxHealth         = 20
+	MaxFood           = 20
+	MaxAir            = 300
+	DefaultSaturation = 5
+	// MaxExhaustion is exhaustion costing a saturation or food point
+	MaxExhaustion = 4
+)
+
+// exhaustion of actions
+const (
+	ExhaustSprint     = 0.1 // per block
+	ExhaustSwim       = 0.01
+	ExhaustJump       = 0.05
+	ExhaustSprintJump = 0.2
+	ExhaustBreakBlock = 0.005
+	ExhaustDamage     = 0.1
+	ExhaustRegen      = 6
+)
+
+// VoidY is where players start taking void damage.
+const VoidY = -64
+
+// hurtCooldown is ticks after hurt when only damage greater than the last one hurts.
+const hurtCooldown = 10
+
+// statuses of Entity Status
+const (
+	EntityStatusHurt  = 2
+	EntityStatusDeath = 3
+)
+
+// ClientStatusRespawn is action of Client Status to respawn after death.
+const ClientStatusRespawn = 0
+
+// DamageCause is why a player is hurt, it decides the death message.
+type DamageCause int
+
+const (
+	DamageGeneric DamageCause = iota
+	DamageFall
+	DamageVoid
+	DamageDrown
+	DamageInFire
+	DamageOnFire
+	DamageLava
+	DamageStarve
+)
+
+// death messages of causes with the player name
+var deathMessages = map[DamageCause]string{
+	DamageGeneric: "%s死了",
+	DamageFall:    "%s落地过猛",
+	DamageVoid:    "%s掉出了这个世界",
+	DamageDrown:   "%s淹死了",
+	DamageInFire:  "%s浴火焚身",
+	DamageOnFire:  "%s被烧死了",
+	DamageLava:    "%s试图在熔岩里游泳",
+	DamageStarve:  "%s饿死了",
+}
+
+// Vitals is health and hunger of player, they are saved in player data.
+type Vitals struct {
+	Health     float32
+	Food       int32
+	Saturation float32
+	Exhaustion float32
+}
+
+// DefaultVitals return vitals of a new or respawned player.
+func DefaultVitals() Vitals {
+	return Vitals{Health: MaxHealth, Food: MaxFood, Saturation: DefaultSaturation}
+}
+
+// hurting is state of player being hurt, changed only in tick.
+type hurting struct {
+	fallDistance float64
+	air          int
+	fireTicks    int
+	// hurtTicks is cooldown of lastDamage
+	hurtTicks  int
+	lastDamage float32
+	// foodTicks counts to next regeneration or starvation
+	foodTicks int
+	dead      bool
+	// sent is vitals last sent in Update Health
+	sent Vitals
+}
+
+// NewUpdateHealthPacket build Update Health, client shows death screen if health is 0.
+func NewUpdateHealthPacket(v Vitals) packet.Packet {
+	pkt := packet.NewPacket(0x41)
+	pkt.WriteFloat(v.Health).WriteVarInt(uint64(v.Food)).WriteFloat(v.Saturation)
+	return pkt
+}
+
+// NewCombatDeathPacket build Combat Event of entity dead, killer is -1 if none.
+func NewCombatDeathPacket(playerID, killerID int32, msg Chat) packet.Packet {
+	pkt := packet.NewPacket(0x2D)
+	pkt.WriteVarInt(2).
+		WriteVarInt(uint64(playerID)).
+		WriteInt(uint32(killerID)).
+		WriteString(msg.String())
+	return pkt
+}
+
+// NewEntityStatusPacket build Entity Status, like hurt and death animation.
+func NewEntityStatusPacket(entityID int32, status byte) packet.Packet {
+	pkt := packet.NewPacket(0x1B)
+	pkt.WriteInt(uint32(entityID)).WriteUByte(status)
+	return pkt
+}
+
+// SendHealth send health and hunger to player.
+func (player *Player) SendHealth() {
+	player.hurting.sent = player.vitals
+	player.Send(NewUpdateHealthPacket(player.vitals))
+}
+
+// exhaust add exhaustion of an action, players who can't be hurt never get hungry.
+func (player *Player) exhaust(exhaustion float32) {
+	if player.abilities.Invulnerable {
+		return
+	}
+	player.vitals.Exhaustion = float32(math.Min(float64(player.vitals.Exhaustion+exhaustion), 40))
+}
+
+// heal add health up to max.
+func (player *Player) heal(amount float32) {
+	player.vitals.Health = float32(math.Min(float64(player.vitals.Health+amount), MaxHealth))
+}
+
+// Damage hurt player by amount of cause, players can't be hurt only take void damage.
+func (player *Player) Damage(amount float32, cause DamageCause) {
+	h := &player.hurting
+	if h.dead || amount <= 0 || player.abilities.Invulnerable && cause != DamageVoid {
+		return
+	}
+	if h.hurtTicks > 0 {
+		// only the part greater than last damage hurts in cooldown
+		if amount <= h.lastDamage {
+			return
+		}
+		amount, h.lastDamage = amount-h.lastDamage, amount
+	} else {
+		h.lastDamage, h.hurtTicks = amount, hurtCooldown
+	}
+	switch cause {
+	case DamageInFire, DamageOnFire, DamageLava:
+		player.exhaust(ExhaustDamage)
+	}
+
+	player.vitals.Health -= amount
+	status := NewEntityStatusPacket(player.ID(), EntityStatusHurt)
+	player.Send(status)
+	player.server.tracker.SendToWatchers(player, status)
+	if player.vitals.Health <= 0 {
+		player.die(cause)
+	}
+}
+
+// die kill player, the client shows death screen until player respawns.
+func (player *Player) die(cause DamageCause) {
+	s := player.server
+	player.hurting.dead = true
+	player.vitals.Health = 0
+	player.stopDigging()
+	player.CloseWindow(true)
+	player.SendHealth()
+	player.meta.SetFloat(world.MetaIndexHealth, 0)
+	player.FlushMetadata()
+
+	msg := Chat{Text: fmt.Sprintf(deathMessages[cause], player.Meta.User)}
+	player.Send(NewCombatDeathPacket(player.ID(), -1, msg))
+	s.tracker.SendToWatchers(player, NewEntityStatusPacket(player.ID(), EntityStatusDeath))
+	level := player.world.Level()
+	if level.BoolGameRule(world.GameRuleShowDeathMsg) {
+		for _, p := range s.Players() {
+			p.SendChat(msg)
+		}
+	}
+	if !level.BoolGameRule(world.GameRuleKeepInventory) {
+		// items can't be dropped yet, they are gone
+		inv := player.inventory
+		held := inv.Held
+		*inv = *NewInventory()
+		inv.Held = held
+		player.SendInventory()
+	}
+}
+
+// HandleClientStatus handle Client Status, dead player respawns at spawn point of default world.
+func (player *Player) HandleClientStatus(action int) {
+	if action != ClientStatusRespawn || !player.hurting.dead {
+		return
+	}
+	s := player.server
+	player.vitals = DefaultVitals()
+	player.hurting = hurting{air: MaxAir}
+	player.meta.SetVarInt(world.MetaIndexAir, MaxAir)
+	player.meta.SetFloat(world.MetaIndexHealth, MaxHealth)
+	world.SetEntityFlag(player.meta, world.EntityFlagOnFire, false)
+
+	// health and metadata are sent when player arrives
+	w := s.worlds.Default()
+	s.Transfer(player, w, s.SpawnPosition(w))
+}
+
+// blockAt return id of block at x, y, z in world of player.
+func (player *Player) blockAt(x, y, z float64) uint16 {
+	return player.world.Block(int(math.Floor(x)), int(math.Floor(y)), int(math.Floor(z))).ID()
+}
+
+func isWater(id uint16) bool { return id == world.BlockWater || id == world.BlockFlowingWater }
+func isLava(id uint16) bool  { return id == world.BlockLava || id == world.BlockFlowingLava }
+
+// move handle player moved by delta, falling and exhaustion of moving are counted.
+func (player *Player) move(delta world.Vec3, wasOnGround bool) {
+	if player.hurting.dead {
+		return
+	}
+	pl := player.PL
+	feet := player.blockAt(pl.X, pl.Y, pl.Z)
+	inWater := isWater(feet)
+	sprinting := world.HasEntityFlag(player.meta, world.EntityFlagSprinting)
+
+	h := &player.hurting
+	if delta.Y < 0 {
+		h.fallDistance -= delta.Y
+	}
+	switch {
+	case player.abilities.Flying || inWater || feet == world.BlockLadder || feet == world.BlockVine:
+		h.fallDistance = 0
+	case pl.OnGround:
+		if damage := math.Ceil(h.fallDistance - 3); damage > 0 {
+			player.Damage(float32(damage), DamageFall)
+		}
+		h.fallDistance = 0
+	}
+
+	// moves longer than a few blocks are teleports
+	if dist := math.Sqrt(delta.X*delta.X + delta.Z*delta.Z); dist < 8 {
+		switch {
+		case inWater:
+			player.exhaust(float32(dist) * ExhaustSwim)
+		case sprinting:
+			player.exhaust(float32(dist) * ExhaustSprint)
+		}
+	}
+	if wasOnGround && !pl.OnGround && delta.Y > 0 {
+		if sprinting {
+			player.exhaust(ExhaustSprintJump)
+		} else {
+			player.exhaust(ExhaustJump)
+		}
+	}
+}
+
+// tickHealth hurt player by void, water, fire and lava, and regenerate or starve by hunger.
+func (player *Player) tickHealth() {
+	h := &player.hurting
+	if h.dead {
+		return
+	}
+	if h.hurtTicks > 0 {
+		h.hurtTicks--
+	}
+	pl := player.PL
+
+	if pl.Y < VoidY {
+		player.Damage(4, DamageVoid)
+	}
+
+	feet := player.blockAt(pl.X, pl.Y, pl.Z)
+	head := player.blockAt(pl.X, pl.Y+1.62, pl.Z)
+	if isWater(head) && !player.abilities.Invulnerable {
+		if h.air--; h.air <= -20 {
+			h.air = 0
+			player.Damage(2, DamageDrown)
+		}
+	} else {
+		h.air = MaxAir
+	}
+	player.meta.SetVarInt(world.MetaIndexAir, int32(h.air))
+
+	switch {
+	case player.abilities.Invulnerable || isWater(feet) || isWater(head):
+		h.fireTicks = 0
+	case isLava(feet) || isLava(head):
+		player.Damage(4, DamageLava)
+		h.fireTicks = 15 * 20
+	case feet == world.BlockFire:
+		player.Damage(1, DamageInFire)
+		if h.fireTicks < 8*20 {
+			h.fireTicks = 8 * 20
+		}
+	}
+	if h.fireTicks > 0 {
+		if h.fireTicks%20 == 0 {
+			player.Damage(1, DamageOnFire)
+		}
+		h.fireTicks--
+	}
+	world.SetEntityFlag(player.meta, world.EntityFlagOnFire, h.fireTicks > 0)
+
+	if !h.dead {
+		player.tickFood()
+	}
+	if h.dead {
+		return
+	}
+	// exhaustion is not shown, health is sent once a tick if anything else changed
+	v, sent := player.vitals, h.sent
+	if v.Health != sent.Health || v.Food != sent.Food || v.Saturation != sent.Saturation {
+		player.SendHealth()
+	}
+	player.meta.SetFloat(world.MetaIndexHealth, player.vitals.Health)
+	player.FlushMetadata()
+}
+
+// tickFood spend exhaustion, regenerate with enough food and starve without food like vanilla.
+func (player *Player) tickFood() {
+	v, h := &player.vitals, &player.hurting
+	level := player.world.Level()
+	difficulty := level.Difficulty
+	regen := level.BoolGameRule(world.GameRuleNaturalRegen)
+
+	if difficulty == constants.DifficultyPeaceful {
+		// peaceful heals and feeds players slowly
+		h.foodTicks++
+		if h.foodTicks%20 == 0 && regen && v.Health < MaxHealth {
+			player.heal(1)
+		}
+		if h.foodTicks%10 == 0 && v.Food < MaxFood {
+			v.Food++
+		}
+	}
+
+	if v.Exhaustion > MaxExhaustion {
+		v.Exhaustion -= MaxExhaustion
+		if v.Saturation > 0 {
+			v.Saturation = float32(math.Max(float64(v.Saturation-1), 0))
+		} else if difficulty != constants.DifficultyPeaceful && v.Food > 0 {
+			v.Food--
+		}
+	}
+	if difficulty == constants.DifficultyPeaceful {
+		return
+	}
+
+	switch {
+	case regen && v.Saturation > 0 && v.Food >= MaxFood && v.Health < MaxHealth:
+		// fast regeneration by saturation
+		if h.foodTicks++; h.foodTicks >= 10 {
+			amount := float32(math.Min(float64(v.Saturation), ExhaustRegen))
+			player.heal(amount / ExhaustRegen)
+			player.exhaust(amount)
+			h.foodTicks = 0
+		}
+	case regen && v.Food >= 18 && v.Health < MaxHealth:
+		if h.foodTicks++; h.foodTicks >= 80 {
+			player.heal(1)
+			player.exhaust(ExhaustRegen)
+			h.foodTicks = 0
+		}
+	case v.Food <= 0:
+		if h.foodTicks++; h.foodTicks >= 80 {
+			// starving stops at half health on easy and at half a heart on normal
+			if v.Health > 10 || difficulty == constants.DifficultyHard || v.Health > 1 && difficulty == constants.DifficultyNormal {
+				player.Damage(1, DamageStarve)
+			}
+			h.foodTicks = 0
+		}
+	default:
+		h.foodTicks = 0
+	}
+}
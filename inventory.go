@@ -0,0 +1,312 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/laushunyu/real/constants"
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+)
+
+// window slots of player inventory
+const (
+	SlotCraftResult = 0
+	SlotCraftStart  = 1
+	SlotCraftEnd    = 4
+	SlotHead        = 5
+	SlotFeet        = 8
+	SlotMainStart   = 9
+	SlotMainEnd     = 35
+	SlotHotbarStart = 36
+	SlotHotbarEnd   = 44
+	SlotOffhand     = 45
+	InventorySize   = 46
+)
+
+// special windows and slots of Set Slot and Click Window
+const (
+	WindowInventory = 0
+	// WindowCursor and SlotCursor in Set Slot set item on cursor
+	WindowCursor = -1
+	SlotCursor   = -1
+	// SlotOutside is clicking outside of window
+	SlotOutside = -999
+)
+
+// Inventory is the 46 slots of player inventory window and the item on cursor.
+type Inventory struct {
+	slots [InventorySize]stream.Slot
+	// Cursor is item picked by mouse while a window is open
+	Cursor stream.Slot
+	// Held is the selected hotbar slot, 0 to 8
+	Held int
+}
+
+func NewInventory() *Inventory {
+	inv := &Inventory{Cursor: stream.EmptySlot}
+	for i := range inv.slots {
+		inv.slots[i] = stream.EmptySlot
+	}
+	return inv
+}
+
+// Slot return item in window slot, empty if slot is out of window.
+func (inv *Inventory) Slot(slot int) stream.Slot {
+	if slot < 0 || slot >= InventorySize {
+		return stream.EmptySlot
+	}
+	return inv.slots[slot]
+}
+
+// SetSlot set item in window slot, it reports false if slot is out of window.
+func (inv *Inventory) SetSlot(slot int, it stream.Slot) bool {
+	if slot < 0 || slot >= InventorySize {
+		return false
+	}
+	if it.Empty() {
+		it = stream.EmptySlot
+	}
+	inv.slots[slot] = it
+	return true
+}
+
+// Slots return all window slots in order.
+func (inv *Inventory) Slots() []stream.Slot {
+	return append([]stream.Slot(nil), inv.slots[:]...)
+}
+
+// HeldSlot return window slot of the selected hotbar slot.
+func (inv *Inventory) HeldSlot() int {
+	return SlotHotbarStart + inv.Held
+}
+
+// Add put items into inventory like picking up, stacks in hotbar and main are filled first,
+// then empty slots from hotbar. It returns items left.
+func (inv *Inventory) Add(it stream.Slot) stream.Slot {
+	order := make([]int, 0, SlotHotbarEnd-SlotMainStart+1)
+	for i := SlotHotbarStart; i <= SlotHotbarEnd; i++ {
+		order = append(order, i)
+	}
+	for i := SlotMainStart; i <= SlotMainEnd; i++ {
+		order = append(order, i)
+	}
+
+	for _, slot := range order {
+		if it.Empty() {
+			return stream.EmptySlot
+		}
+		if s := &inv.slots[slot]; !s.Empty() && Stackable(*s, it) {
+			n := minInt(int(it.Count), MaxStack(it)-int(s.Count))
+			if n > 0 {
+				s.Count += byte(n)
+				it.Count -= byte(n)
+			}
+		}
+	}
+	for _, slot := range order {
+		if it.Empty() {
+			return stream.EmptySlot
+		}
+		if inv.slots[slot].Empty() {
+			n := minInt(int(it.Count), MaxStack(it))
+			inv.slots[slot] = it
+			inv.slots[slot].Count = byte(n)
+			it.Count -= byte(n)
+		}
+	}
+	if it.Empty() {
+		return stream.EmptySlot
+	}
+	return it
+}
+
+// MaxStack return how many items of it can be in a slot.
+func MaxStack(it stream.Slot) int {
+	if info := item.ByID(uint16(it.ID)); info != nil && info.MaxStack > 0 {
+		return int(info.MaxStack)
+	}
+	return 64
+}
+
+// Stackable report whether a and b are the same kind of item.
+func Stackable(a, b stream.Slot) bool {
+	if a.ID != b.ID || a.Damage != b.Damage {
+		return false
+	}
+	return len(a.NBT) == 0 && len(b.NBT) == 0 || reflect.DeepEqual(a.NBT, b.NBT)
+}
+
+// ValidItem report whether it is a known item that fits in a slot.
+func ValidItem(it stream.Slot) bool {
+	if it.Empty() {
+		return true
+	}
+	return item.ByID(uint16(it.ID)) != nil && int(it.Count) <= MaxStack(it)
+}
+
+// armorSlot return window slot where item can be worn, -1 if it is not armor.
+func armorSlot(it stream.Slot) int {
+	info := item.ByID(uint16(it.ID))
+	if info == nil {
+		return -1
+	}
+	switch name := info.Name; {
+	case strings.HasSuffix(name, "_helmet"), name == "minecraft:pumpkin", name == "minecraft:skull":
+		return SlotHead
+	case strings.HasSuffix(name, "_chestplate"), name == "minecraft:elytra":
+		return SlotHead + 1
+	case strings.HasSuffix(name, "_leggings"):
+		return SlotHead + 2
+	case strings.HasSuffix(name, "_boots"):
+		return SlotFeet
+	}
+	return -1
+}
+
+// canPlace report whether it can be put into window slot of player inventory.
+func canPlace(slot int, it stream.Slot) bool {
+	switch {
+	case slot == SlotCraftResult:
+		return false
+	case slot >= SlotHead && slot <= SlotFeet:
+		return armorSlot(it) == slot
+	}
+	return true
+}
+
+// NewSetSlotPacket build Set Slot of slot in window.
+func NewSetSlotPacket(window int8, slot int16, it stream.Slot) packet.Packet {
+	pkt := packet.NewPacket(0x16)
+	pkt.WriteUByte(byte(window)).WriteShort(uint16(slot)).WriteSlot(it)
+	return pkt
+}
+
+// NewWindowItemsPacket build Window Items of all slots in window.
+func NewWindowItemsPacket(window byte, slots []stream.Slot) packet.Packet {
+	pkt := packet.NewPacket(0x14)
+	pkt.WriteUByte(window).WriteShort(uint16(len(slots)))
+	for _, it := range slots {
+		pkt.WriteSlot(it)
+	}
+	return pkt
+}
+
+// NewHeldItemChangePacket build clientbound Held Item Change.
+func NewHeldItemChangePacket(held int) packet.Packet {
+	pkt := packet.NewPacket(0x3A)
+	pkt.WriteUByte(byte(held))
+	return pkt
+}
+
+// SendInventory send all slots, the cursor and the selected slot to player,
+// it is also used to undo what client did if server refused.
+func (player *Player) SendInventory() {
+	inv := player.inventory
+	player.Send(NewWindowItemsPacket(WindowInventory, inv.Slots()))
+	player.Send(NewSetSlotPacket(WindowCursor, SlotCursor, inv.Cursor))
+	player.Send(NewHeldItemChangePacket(inv.Held))
+}
+
+// SendSlot send item in window slot of player inventory.
+func (player *Player) SendSlot(slot int) {
+	player.Send(NewSetSlotPacket(WindowInventory, int16(slot), player.inventory.Slot(slot)))
+}
+
+// HeldItem return item in hand, hand 1 is the off hand.
+func (player *Player) HeldItem(hand int) stream.Slot {
+	if hand == 1 {
+		return player.inventory.Slot(SlotOffhand)
+	}
+	return player.inventory.Slot(player.inventory.HeldSlot())
+}
+
+// ConsumeHeldItem take one item from hand after it is used up.
+func (player *Player) ConsumeHeldItem(hand int) {
+	slot := player.inventory.HeldSlot()
+	if hand == 1 {
+		slot = SlotOffhand
+	}
+	it := player.inventory.Slot(slot)
+	if it.Empty() {
+		return
+	}
+	it.Count--
+	player.inventory.SetSlot(slot, it)
+}
+
+// HandleHeldItemChange handle serverbound Held Item Change.
+func (player *Player) HandleHeldItemChange(held int) {
+	if held < 0 || held > 8 {
+		player.Send(NewHeldItemChangePacket(player.inventory.Held))
+		return
+	}
+	player.stopDigging()
+	player.inventory.Held = held
+}
+
+// HandleCreativeInventoryAction handle item taken from creative inventory into slot,
+// slot -1 is dropping the item.
+func (player *Player) HandleCreativeInventoryAction(slot int, it stream.Slot) {
+	if player.GameMode != constants.GameModeCreative {
+		player.SendInventory()
+		return
+	}
+	if slot == SlotCursor {
+		// item entities are not spawned yet, dropped items are gone
+		return
+	}
+	if slot <= SlotCraftResult || !ValidItem(it) || !player.inventory.SetSlot(slot, it) {
+		player.SendSlot(slot)
+		return
+	}
+	if slot >= SlotCraftStart && slot <= SlotCraftEnd {
+		player.sendResult(player.invWindow)
+	}
+}
+
+// dataSlot return slot of player data of window slot, false if the slot is not saved.
+func dataSlot(slot int) (int8, bool) {
+	switch {
+	case slot >= SlotHotbarStart && slot <= SlotHotbarEnd:
+		return int8(slot - SlotHotbarStart), true
+	case slot >= SlotMainStart && slot <= SlotMainEnd:
+		return int8(slot), true
+	case slot >= SlotHead && slot <= SlotFeet:
+		return int8(world.PlayerSlotHead - (slot - SlotHead)), true
+	case slot == SlotOffhand:
+		return world.PlayerSlotOffhand, true
+	}
+	return 0, false
+}
+
+// Data return items by slot of player data, crafting grid and cursor are not saved.
+func (inv *Inventory) Data() map[int8]stream.Slot {
+	data := make(map[int8]stream.Slot)
+	for slot, it := range inv.slots {
+		if i, ok := dataSlot(slot); ok && !it.Empty() {
+			data[i] = it
+		}
+	}
+	return data
+}
+
+// SetData set items by slot of player data.
+func (inv *Inventory) SetData(data map[int8]stream.Slot) {
+	for slot := range inv.slots {
+		if i, ok := dataSlot(slot); ok {
+			if it, ok := data[i]; ok {
+				inv.SetSlot(slot, it)
+			}
+		}
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,103 @@
+//go:build ignore
+
+// gen_items generate items_gen.go from items.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+type variant struct {
+	Damage int16  `json:"damage"`
+	Meta   uint8  `json:"meta"`
+	Name   string `json:"name"`
+}
+
+type item struct {
+	ID         uint16    `json:"id"`
+	Name       string    `json:"name"`
+	Stack      uint8     `json:"stack"`
+	Durability int16     `json:"durability"`
+	Block      *uint16   `json:"block"`
+	Variants   []variant `json:"variants"`
+}
+
+type block struct {
+	ID   uint16 `json:"id"`
+	Name string `json:"name"`
+}
+
+// camel convert minecraft:flowing_water to FlowingWater.
+func camel(name string) string {
+	name = strings.TrimPrefix(name, "minecraft:")
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func load(file string, v interface{}) {
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	var items []item
+	load("items.json", &items)
+	var blocks []block
+	load("../world/blocks.json", &blocks)
+	blockConst := make(map[uint16]string)
+	for _, b := range blocks {
+		blockConst[b.ID] = "world.Block" + camel(b.Name)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintln(buf, `// Code generated by "go run gen_items.go"; DO NOT EDIT.`)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package item")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, `import "github.com/laushunyu/real/world"`)
+	fmt.Fprintln(buf)
+
+	fmt.Fprintln(buf, "// ids of items")
+	fmt.Fprintln(buf, "const (")
+	for _, it := range items {
+		fmt.Fprintf(buf, "%s uint16 = %d\n", camel(it.Name), it.ID)
+	}
+	fmt.Fprintln(buf, ")")
+	fmt.Fprintln(buf)
+
+	fmt.Fprintln(buf, "var items = []Item{")
+	for _, it := range items {
+		fmt.Fprintf(buf, "{ID: %d, Name: %q, MaxStack: %d, Durability: %d", it.ID, it.Name, it.Stack, it.Durability)
+		if it.Block != nil {
+			fmt.Fprintf(buf, ", Block: %s, PlacesBlock: true", blockConst[*it.Block])
+		}
+		fmt.Fprintln(buf, ", Variants: []Variant{")
+		for _, v := range it.Variants {
+			fmt.Fprintf(buf, "{Damage: %d, Name: %q, Meta: %d},\n", v.Damage, v.Name, v.Meta)
+		}
+		fmt.Fprintln(buf, "}},")
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("items_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,85 @@
+package item
+
+//go:generate go run gen_items.go
+
+import (
+	"strings"
+
+	"github.com/laushunyu/real/world"
+)
+
+// Variant is a damage value of item which has sub types, like colors of wool.
+type Variant struct {
+	Damage int16
+	Name   string
+	// Meta of block placed by this variant
+	Meta uint8
+}
+
+type Item struct {
+	ID         uint16
+	Name       string
+	MaxStack   uint8
+	Durability int16 // max damage of tools and armors, 0 if not damageable
+	// Block placed by item if PlacesBlock
+	Block       uint16
+	PlacesBlock bool
+	Variants    []Variant
+}
+
+// Damageable report whether damage of item is durability instead of sub type.
+func (it *Item) Damageable() bool {
+	return it.Durability > 0
+}
+
+// Variant return variant of damage, nil if item has no such sub type.
+func (it *Item) Variant(damage int16) *Variant {
+	if it.Damageable() {
+		return &it.Variants[0]
+	}
+	for i := range it.Variants {
+		if it.Variants[i].Damage == damage {
+			return &it.Variants[i]
+		}
+	}
+	return nil
+}
+
+// BlockState return block state placed by item of damage,
+// orientation and other properties depend on placement are not set.
+func (it *Item) BlockState(damage int16) (world.BlockState, bool) {
+	if !it.PlacesBlock {
+		return world.Air, false
+	}
+	v := it.Variant(damage)
+	if v == nil {
+		return world.Air, false
+	}
+	return world.NewBlockState(it.Block, v.Meta), true
+}
+
+var (
+	itemByID   = make(map[uint16]*Item)
+	itemByName = make(map[string]*Item)
+)
+
+func init() {
+	for i := range items {
+		it := &items[i]
+		itemByID[it.ID] = it
+		itemByName[it.Name] = it
+	}
+}
+
+// ByID return item of id, nil if not found.
+func ByID(id uint16) *Item {
+	return itemByID[id]
+}
+
+// ByName return item of name, the minecraft: prefix can be omitted.
+func ByName(name string) *Item {
+	if !strings.Contains(name, ":") {
+		name = "minecraft:" + name
+	}
+	return itemByName[name]
+}
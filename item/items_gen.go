@@ -0,0 +1,1887 @@
+// Code generated by "go run gen_items.go"; DO NOT EDIT.
+
+package item
+
+import "github.com/laushunyu/real/world"
+
+// ids of items
+const (
+	Stone                      uint16 = 1
+	Grass                      uint16 = 2
+	Dirt                       uint16 = 3
+	Cobblestone                uint16 = 4
+	Planks                     uint16 = 5
+	Sapling                    uint16 = 6
+	Bedrock                    uint16 = 7
+	Sand                       uint16 = 12
+	Gravel                     uint16 = 13
+	GoldOre                    uint16 = 14
+	IronOre                    uint16 = 15
+	CoalOre                    uint16 = 16
+	Log                        uint16 = 17
+	Leaves                     uint16 = 18
+	Sponge                     uint16 = 19
+	Glass                      uint16 = 20
+	LapisOre                   uint16 = 21
+	LapisBlock                 uint16 = 22
+	Dispenser                  uint16 = 23
+	Sandstone                  uint16 = 24
+	Noteblock                  uint16 = 25
+	GoldenRail                 uint16 = 27
+	DetectorRail               uint16 = 28
+	StickyPiston               uint16 = 29
+	Web                        uint16 = 30
+	Tallgrass                  uint16 = 31
+	Deadbush                   uint16 = 32
+	Piston                     uint16 = 33
+	Wool                       uint16 = 35
+	YellowFlower               uint16 = 37
+	RedFlower                  uint16 = 38
+	BrownMushroom              uint16 = 39
+	RedMushroom                uint16 = 40
+	GoldBlock                  uint16 = 41
+	IronBlock                  uint16 = 42
+	StoneSlab                  uint16 = 44
+	BrickBlock                 uint16 = 45
+	Tnt                        uint16 = 46
+	Bookshelf                  uint16 = 47
+	MossyCobblestone           uint16 = 48
+	Obsidian                   uint16 = 49
+	Torch                      uint16 = 50
+	MobSpawner                 uint16 = 52
+	OakStairs                  uint16 = 53
+	Chest                      uint16 = 54
+	DiamondOre                 uint16 = 56
+	DiamondBlock               uint16 = 57
+	CraftingTable              uint16 = 58
+	Farmland                   uint16 = 60
+	Furnace                    uint16 = 61
+	Ladder                     uint16 = 65
+	Rail                       uint16 = 66
+	StoneStairs                uint16 = 67
+	Lever                      uint16 = 69
+	StonePressurePlate         uint16 = 70
+	WoodenPressurePlate        uint16 = 72
+	RedstoneOre                uint16 = 73
+	RedstoneTorch              uint16 = 76
+	StoneButton                uint16 = 77
+	SnowLayer                  uint16 = 78
+	Ice                        uint16 = 79
+	Snow                       uint16 = 80
+	Cactus                     uint16 = 81
+	Clay                       uint16 = 82
+	Jukebox                    uint16 = 84
+	Fence                      uint16 = 85
+	Pumpkin                    uint16 = 86
+	Netherrack                 uint16 = 87
+	SoulSand                   uint16 = 88
+	Glowstone                  uint16 = 89
+	LitPumpkin                 uint16 = 91
+	StainedGlass               uint16 = 95
+	Trapdoor                   uint16 = 96
+	MonsterEgg                 uint16 = 97
+	Stonebrick                 uint16 = 98
+	BrownMushroomBlock         uint16 = 99
+	RedMushroomBlock           uint16 = 100
+	IronBars                   uint16 = 101
+	GlassPane                  uint16 = 102
+	MelonBlock                 uint16 = 103
+	Vine                       uint16 = 106
+	FenceGate                  uint16 = 107
+	BrickStairs                uint16 = 108
+	StoneBrickStairs           uint16 = 109
+	Mycelium                   uint16 = 110
+	Waterlily                  uint16 = 111
+	NetherBrick                uint16 = 112
+	NetherBrickFence           uint16 = 113
+	NetherBrickStairs          uint16 = 114
+	EnchantingTable            uint16 = 116
+	EndPortalFrame             uint16 = 120
+	EndStone                   uint16 = 121
+	DragonEgg                  uint16 = 122
+	RedstoneLamp               uint16 = 123
+	WoodenSlab                 uint16 = 126
+	SandstoneStairs            uint16 = 128
+	EmeraldOre                 uint16 = 129
+	EnderChest                 uint16 = 130
+	TripwireHook               uint16 = 131
+	EmeraldBlock               uint16 = 133
+	SpruceStairs               uint16 = 134
+	BirchStairs                uint16 = 135
+	JungleStairs               uint16 = 136
+	CommandBlock               uint16 = 137
+	Beacon                     uint16 = 138
+	CobblestoneWall            uint16 = 139
+	WoodenButton               uint16 = 143
+	Anvil                      uint16 = 145
+	TrappedChest               uint16 = 146
+	LightWeightedPressurePlate uint16 = 147
+	HeavyWeightedPressurePlate uint16 = 148
+	DaylightDetector           uint16 = 151
+	RedstoneBlock              uint16 = 152
+	QuartzOre                  uint16 = 153
+	Hopper                     uint16 = 154
+	QuartzBlock                uint16 = 155
+	QuartzStairs               uint16 = 156
+	ActivatorRail              uint16 = 157
+	Dropper                    uint16 = 158
+	StainedHardenedClay        uint16 = 159
+	StainedGlassPane           uint16 = 160
+	Leaves2                    uint16 = 161
+	Log2                       uint16 = 162
+	AcaciaStairs               uint16 = 163
+	DarkOakStairs              uint16 = 164
+	Slime                      uint16 = 165
+	Barrier                    uint16 = 166
+	IronTrapdoor               uint16 = 167
+	Prismarine                 uint16 = 168
+	SeaLantern                 uint16 = 169
+	HayBlock                   uint16 = 170
+	Carpet                     uint16 = 171
+	HardenedClay               uint16 = 172
+	CoalBlock                  uint16 = 173
+	PackedIce                  uint16 = 174
+	DoublePlant                uint16 = 175
+	RedSandstone               uint16 = 179
+	RedSandstoneStairs         uint16 = 180
+	StoneSlab2                 uint16 = 182
+	SpruceFenceGate            uint16 = 183
+	BirchFenceGate             uint16 = 184
+	JungleFenceGate            uint16 = 185
+	DarkOakFenceGate           uint16 = 186
+	AcaciaFenceGate            uint16 = 187
+	SpruceFence                uint16 = 188
+	BirchFence                 uint16 = 189
+	JungleFence                uint16 = 190
+	DarkOakFence               uint16 = 191
+	AcaciaFence                uint16 = 192
+	EndRod                     uint16 = 198
+	ChorusPlant                uint16 = 199
+	ChorusFlower               uint16 = 200
+	PurpurBlock                uint16 = 201
+	PurpurPillar               uint16 = 202
+	PurpurStairs               uint16 = 203
+	PurpurSlab                 uint16 = 205
+	EndBricks                  uint16 = 206
+	GrassPath                  uint16 = 208
+	RepeatingCommandBlock      uint16 = 210
+	ChainCommandBlock          uint16 = 211
+	Magma                      uint16 = 213
+	NetherWartBlock            uint16 = 214
+	RedNetherBrick             uint16 = 215
+	BoneBlock                  uint16 = 216
+	StructureVoid              uint16 = 217
+	Observer                   uint16 = 218
+	WhiteShulkerBox            uint16 = 219
+	OrangeShulkerBox           uint16 = 220
+	MagentaShulkerBox          uint16 = 221
+	LightBlueShulkerBox        uint16 = 222
+	YellowShulkerBox           uint16 = 223
+	LimeShulkerBox             uint16 = 224
+	PinkShulkerBox             uint16 = 225
+	GrayShulkerBox             uint16 = 226
+	SilverShulkerBox           uint16 = 227
+	CyanShulkerBox             uint16 = 228
+	PurpleShulkerBox           uint16 = 229
+	BlueShulkerBox             uint16 = 230
+	BrownShulkerBox            uint16 = 231
+	GreenShulkerBox            uint16 = 232
+	RedShulkerBox              uint16 = 233
+	BlackShulkerBox            uint16 = 234
+	WhiteGlazedTerracotta      uint16 = 235
+	OrangeGlazedTerracotta     uint16 = 236
+	MagentaGlazedTerracotta    uint16 = 237
+	LightBlueGlazedTerracotta  uint16 = 238
+	YellowGlazedTerracotta     uint16 = 239
+	LimeGlazedTerracotta       uint16 = 240
+	PinkGlazedTerracotta       uint16 = 241
+	GrayGlazedTerracotta       uint16 = 242
+	SilverGlazedTerracotta     uint16 = 243
+	CyanGlazedTerracotta       uint16 = 244
+	PurpleGlazedTerracotta     uint16 = 245
+	BlueGlazedTerracotta       uint16 = 246
+	BrownGlazedTerracotta      uint16 = 247
+	GreenGlazedTerracotta      uint16 = 248
+	RedGlazedTerracotta        uint16 = 249
+	BlackGlazedTerracotta      uint16 = 250
+	Concrete                   uint16 = 251
+	ConcretePowder             uint16 = 252
+	StructureBlock             uint16 = 255
+	IronShovel                 uint16 = 256
+	IronPickaxe                uint16 = 257
+	IronAxe                    uint16 = 258
+	FlintAndSteel              uint16 = 259
+	Apple                      uint16 = 260
+	Bow                        uint16 = 261
+	Arrow                      uint16 = 262
+	Coal                       uint16 = 263
+	Diamond                    uint16 = 264
+	IronIngot                  uint16 = 265
+	GoldIngot                  uint16 = 266
+	IronSword                  uint16 = 267
+	WoodenSword                uint16 = 268
+	WoodenShovel               uint16 = 269
+	WoodenPickaxe              uint16 = 270
+	WoodenAxe                  uint16 = 271
+	StoneSword                 uint16 = 272
+	StoneShovel                uint16 = 273
+	StonePickaxe               uint16 = 274
+	StoneAxe                   uint16 = 275
+	DiamondSword               uint16 = 276
+	DiamondShovel              uint16 = 277
+	DiamondPickaxe             uint16 = 278
+	DiamondAxe                 uint16 = 279
+	Stick                      uint16 = 280
+	Bowl                       uint16 = 281
+	MushroomStew               uint16 = 282
+	GoldenSword                uint16 = 283
+	GoldenShovel               uint16 = 284
+	GoldenPickaxe              uint16 = 285
+	GoldenAxe                  uint16 = 286
+	String                     uint16 = 287
+	Feather                    uint16 = 288
+	Gunpowder                  uint16 = 289
+	WoodenHoe                  uint16 = 290
+	StoneHoe                   uint16 = 291
+	IronHoe                    uint16 = 292
+	DiamondHoe                 uint16 = 293
+	GoldenHoe                  uint16 = 294
+	WheatSeeds                 uint16 = 295
+	Wheat                      uint16 = 296
+	Bread                      uint16 = 297
+	LeatherHelmet              uint16 = 298
+	LeatherChestplate          uint16 = 299
+	LeatherLeggings            uint16 = 300
+	LeatherBoots               uint16 = 301
+	ChainmailHelmet            uint16 = 302
+	ChainmailChestplate        uint16 = 303
+	ChainmailLeggings          uint16 = 304
+	ChainmailBoots             uint16 = 305
+	IronHelmet                 uint16 = 306
+	IronChestplate             uint16 = 307
+	IronLeggings               uint16 = 308
+	IronBoots                  uint16 = 309
+	DiamondHelmet              uint16 = 310
+	DiamondChestplate          uint16 = 311
+	DiamondLeggings            uint16 = 312
+	DiamondBoots               uint16 = 313
+	GoldenHelmet               uint16 = 314
+	GoldenChestplate           uint16 = 315
+	GoldenLeggings             uint16 = 316
+	GoldenBoots                uint16 = 317
+	Flint                      uint16 = 318
+	Porkchop                   uint16 = 319
+	CookedPorkchop             uint16 = 320
+	Painting                   uint16 = 321
+	GoldenApple                uint16 = 322
+	Sign                       uint16 = 323
+	WoodenDoor                 uint16 = 324
+	Bucket                     uint16 = 325
+	WaterBucket                uint16 = 326
+	LavaBucket                 uint16 = 327
+	Minecart                   uint16 = 328
+	Saddle                     uint16 = 329
+	IronDoor                   uint16 = 330
+	Redstone                   uint16 = 331
+	Snowball                   uint16 = 332
+	Boat                       uint16 = 333
+	Leather                    uint16 = 334
+	MilkBucket                 uint16 = 335
+	Brick                      uint16 = 336
+	ClayBall                   uint16 = 337
+	Reeds                      uint16 = 338
+	Paper                      uint16 = 339
+	Book                       uint16 = 340
+	SlimeBall                  uint16 = 341
+	ChestMinecart              uint16 = 342
+	FurnaceMinecart            uint16 = 343
+	Egg                        uint16 = 344
+	Compass                    uint16 = 345
+	FishingRod                 uint16 = 346
+	Clock                      uint16 = 347
+	GlowstoneDust              uint16 = 348
+	Fish                       uint16 = 349
+	CookedFish                 uint16 = 350
+	Dye                        uint16 = 351
+	Bone                       uint16 = 352
+	Sugar                      uint16 = 353
+	Cake                       uint16 = 354
+	Bed                        uint16 = 355
+	Repeater                   uint16 = 356
+	Cookie                     uint16 = 357
+	FilledMap                  uint16 = 358
+	Shears                     uint16 = 359
+	Melon                      uint16 = 360
+	PumpkinSeeds               uint16 = 361
+	MelonSeeds                 uint16 = 362
+	Beef                       uint16 = 363
+	CookedBeef                 uint16 = 364
+	Chicken                    uint16 = 365
+	CookedChicken              uint16 = 366
+	RottenFlesh                uint16 = 367
+	EnderPearl                 uint16 = 368
+	BlazeRod                   uint16 = 369
+	GhastTear                  uint16 = 370
+	GoldNugget                 uint16 = 371
+	NetherWart                 uint16 = 372
+	Potion                     uint16 = 373
+	GlassBottle                uint16 = 374
+	SpiderEye                  uint16 = 375
+	FermentedSpiderEye         uint16 = 376
+	BlazePowder                uint16 = 377
+	MagmaCream                 uint16 = 378
+	BrewingStand               uint16 = 379
+	Cauldron                   uint16 = 380
+	EnderEye                   uint16 = 381
+	SpeckledMelon              uint16 = 382
+	SpawnEgg                   uint16 = 383
+	ExperienceBottle           uint16 = 384
+	FireCharge                 uint16 = 385
+	WritableBook               uint16 = 386
+	WrittenBook                uint16 = 387
+	Emerald                    uint16 = 388
+	ItemFrame                  uint16 = 389
+	FlowerPot                  uint16 = 390
+	Carrot                     uint16 = 391
+	Potato                     uint16 = 392
+	BakedPotato                uint16 = 393
+	PoisonousPotato            uint16 = 394
+	Map                        uint16 = 395
+	GoldenCarrot               uint16 = 396
+	Skull                      uint16 = 397
+	CarrotOnAStick             uint16 = 398
+	NetherStar                 uint16 = 399
+	PumpkinPie                 uint16 = 400
+	Fireworks                  uint16 = 401
+	FireworkCharge             uint16 = 402
+	EnchantedBook              uint16 = 403
+	Comparator                 uint16 = 404
+	Netherbrick                uint16 = 405
+	Quartz                     uint16 = 406
+	TntMinecart                uint16 = 407
+	HopperMinecart             uint16 = 408
+	PrismarineShard            uint16 = 409
+	PrismarineCrystals         uint16 = 410
+	Rabbit                     uint16 = 411
+	CookedRabbit               uint16 = 412
+	RabbitStew                 uint16 = 413
+	RabbitFoot                 uint16 = 414
+	RabbitHide                 uint16 = 415
+	ArmorStand                 uint16 = 416
+	IronHorseArmor             uint16 = 417
+	GoldenHorseArmor           uint16 = 418
+	DiamondHorseArmor          uint16 = 419
+	Lead                       uint16 = 420
+	NameTag                    uint16 = 421
+	CommandBlockMinecart       uint16 = 422
+	Mutton                     uint16 = 423
+	CookedMutton               uint16 = 424
+	Banner                     uint16 = 425
+	EndCrystal                 uint16 = 426
+	SpruceDoor                 uint16 = 427
+	BirchDoor                  uint16 = 428
+	JungleDoor                 uint16 = 429
+	AcaciaDoor                 uint16 = 430
+	DarkOakDoor                uint16 = 431
+	ChorusFruit                uint16 = 432
+	ChorusFruitPopped          uint16 = 433
+	Beetroot                   uint16 = 434
+	BeetrootSeeds              uint16 = 435
+	BeetrootSoup               uint16 = 436
+	DragonBreath               uint16 = 437
+	SplashPotion               uint16 = 438
+	SpectralArrow              uint16 = 439
+	TippedArrow                uint16 = 440
+	LingeringPotion            uint16 = 441
+	Shield                     uint16 = 442
+	Elytra                     uint16 = 443
+	SpruceBoat                 uint16 = 444
+	BirchBoat                  uint16 = 445
+	JungleBoat                 uint16 = 446
+	AcaciaBoat                 uint16 = 447
+	DarkOakBoat                uint16 = 448
+	TotemOfUndying             uint16 = 449
+	ShulkerShell               uint16 = 450
+	IronNugget                 uint16 = 452
+	KnowledgeBook              uint16 = 453
+	Record13                   uint16 = 2256
+	RecordCat                  uint16 = 2257
+	RecordBlocks               uint16 = 2258
+	RecordChirp                uint16 = 2259
+	RecordFar                  uint16 = 2260
+	RecordMall                 uint16 = 2261
+	RecordMellohi              uint16 = 2262
+	RecordStal                 uint16 = 2263
+	RecordStrad                uint16 = 2264
+	RecordWard                 uint16 = 2265
+	Record11                   uint16 = 2266
+	RecordWait                 uint16 = 2267
+)
+
+var items = []Item{
+	{ID: 1, Name: "minecraft:stone", MaxStack: 64, Durability: 0, Block: world.BlockStone, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "stone", Meta: 0},
+		{Damage: 1, Name: "granite", Meta: 1},
+		{Damage: 2, Name: "smooth_granite", Meta: 2},
+		{Damage: 3, Name: "diorite", Meta: 3},
+		{Damage: 4, Name: "smooth_diorite", Meta: 4},
+		{Damage: 5, Name: "andesite", Meta: 5},
+		{Damage: 6, Name: "smooth_andesite", Meta: 6},
+	}},
+	{ID: 2, Name: "minecraft:grass", MaxStack: 64, Durability: 0, Block: world.BlockGrass, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 3, Name: "minecraft:dirt", MaxStack: 64, Durability: 0, Block: world.BlockDirt, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "dirt", Meta: 0},
+		{Damage: 1, Name: "coarse_dirt", Meta: 1},
+		{Damage: 2, Name: "podzol", Meta: 2},
+	}},
+	{ID: 4, Name: "minecraft:cobblestone", MaxStack: 64, Durability: 0, Block: world.BlockCobblestone, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 5, Name: "minecraft:planks", MaxStack: 64, Durability: 0, Block: world.BlockPlanks, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "oak", Meta: 0},
+		{Damage: 1, Name: "spruce", Meta: 1},
+		{Damage: 2, Name: "birch", Meta: 2},
+		{Damage: 3, Name: "jungle", Meta: 3},
+		{Damage: 4, Name: "acacia", Meta: 4},
+		{Damage: 5, Name: "dark_oak", Meta: 5},
+	}},
+	{ID: 6, Name: "minecraft:sapling", MaxStack: 64, Durability: 0, Block: world.BlockSapling, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "oak", Meta: 0},
+		{Damage: 1, Name: "spruce", Meta: 1},
+		{Damage: 2, Name: "birch", Meta: 2},
+		{Damage: 3, Name: "jungle", Meta: 3},
+		{Damage: 4, Name: "acacia", Meta: 4},
+		{Damage: 5, Name: "dark_oak", Meta: 5},
+	}},
+	{ID: 7, Name: "minecraft:bedrock", MaxStack: 64, Durability: 0, Block: world.BlockBedrock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 12, Name: "minecraft:sand", MaxStack: 64, Durability: 0, Block: world.BlockSand, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "sand", Meta: 0},
+		{Damage: 1, Name: "red_sand", Meta: 1},
+	}},
+	{ID: 13, Name: "minecraft:gravel", MaxStack: 64, Durability: 0, Block: world.BlockGravel, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 14, Name: "minecraft:gold_ore", MaxStack: 64, Durability: 0, Block: world.BlockGoldOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 15, Name: "minecraft:iron_ore", MaxStack: 64, Durability: 0, Block: world.BlockIronOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 16, Name: "minecraft:coal_ore", MaxStack: 64, Durability: 0, Block: world.BlockCoalOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 17, Name: "minecraft:log", MaxStack: 64, Durability: 0, Block: world.BlockLog, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "oak", Meta: 0},
+		{Damage: 1, Name: "spruce", Meta: 1},
+		{Damage: 2, Name: "birch", Meta: 2},
+		{Damage: 3, Name: "jungle", Meta: 3},
+	}},
+	{ID: 18, Name: "minecraft:leaves", MaxStack: 64, Durability: 0, Block: world.BlockLeaves, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "oak", Meta: 0},
+		{Damage: 1, Name: "spruce", Meta: 1},
+		{Damage: 2, Name: "birch", Meta: 2},
+		{Damage: 3, Name: "jungle", Meta: 3},
+	}},
+	{ID: 19, Name: "minecraft:sponge", MaxStack: 64, Durability: 0, Block: world.BlockSponge, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "false", Meta: 0},
+		{Damage: 1, Name: "true", Meta: 1},
+	}},
+	{ID: 20, Name: "minecraft:glass", MaxStack: 64, Durability: 0, Block: world.BlockGlass, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 21, Name: "minecraft:lapis_ore", MaxStack: 64, Durability: 0, Block: world.BlockLapisOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 22, Name: "minecraft:lapis_block", MaxStack: 64, Durability: 0, Block: world.BlockLapisBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 23, Name: "minecraft:dispenser", MaxStack: 64, Durability: 0, Block: world.BlockDispenser, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 24, Name: "minecraft:sandstone", MaxStack: 64, Durability: 0, Block: world.BlockSandstone, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "sandstone", Meta: 0},
+		{Damage: 1, Name: "chiseled_sandstone", Meta: 1},
+		{Damage: 2, Name: "smooth_sandstone", Meta: 2},
+	}},
+	{ID: 25, Name: "minecraft:noteblock", MaxStack: 64, Durability: 0, Block: world.BlockNoteblock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 27, Name: "minecraft:golden_rail", MaxStack: 64, Durability: 0, Block: world.BlockGoldenRail, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 28, Name: "minecraft:detector_rail", MaxStack: 64, Durability: 0, Block: world.BlockDetectorRail, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 29, Name: "minecraft:sticky_piston", MaxStack: 64, Durability: 0, Block: world.BlockStickyPiston, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 30, Name: "minecraft:web", MaxStack: 64, Durability: 0, Block: world.BlockWeb, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 31, Name: "minecraft:tallgrass", MaxStack: 64, Durability: 0, Block: world.BlockTallgrass, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "dead_bush", Meta: 0},
+		{Damage: 1, Name: "tall_grass", Meta: 1},
+		{Damage: 2, Name: "fern", Meta: 2},
+	}},
+	{ID: 32, Name: "minecraft:deadbush", MaxStack: 64, Durability: 0, Block: world.BlockDeadbush, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 33, Name: "minecraft:piston", MaxStack: 64, Durability: 0, Block: world.BlockPiston, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 35, Name: "minecraft:wool", MaxStack: 64, Durability: 0, Block: world.BlockWool, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 1},
+		{Damage: 2, Name: "magenta", Meta: 2},
+		{Damage: 3, Name: "light_blue", Meta: 3},
+		{Damage: 4, Name: "yellow", Meta: 4},
+		{Damage: 5, Name: "lime", Meta: 5},
+		{Damage: 6, Name: "pink", Meta: 6},
+		{Damage: 7, Name: "gray", Meta: 7},
+		{Damage: 8, Name: "silver", Meta: 8},
+		{Damage: 9, Name: "cyan", Meta: 9},
+		{Damage: 10, Name: "purple", Meta: 10},
+		{Damage: 11, Name: "blue", Meta: 11},
+		{Damage: 12, Name: "brown", Meta: 12},
+		{Damage: 13, Name: "green", Meta: 13},
+		{Damage: 14, Name: "red", Meta: 14},
+		{Damage: 15, Name: "black", Meta: 15},
+	}},
+	{ID: 37, Name: "minecraft:yellow_flower", MaxStack: 64, Durability: 0, Block: world.BlockYellowFlower, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 38, Name: "minecraft:red_flower", MaxStack: 64, Durability: 0, Block: world.BlockRedFlower, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "poppy", Meta: 0},
+		{Damage: 1, Name: "blue_orchid", Meta: 1},
+		{Damage: 2, Name: "allium", Meta: 2},
+		{Damage: 3, Name: "houstonia", Meta: 3},
+		{Damage: 4, Name: "red_tulip", Meta: 4},
+		{Damage: 5, Name: "orange_tulip", Meta: 5},
+		{Damage: 6, Name: "white_tulip", Meta: 6},
+		{Damage: 7, Name: "pink_tulip", Meta: 7},
+		{Damage: 8, Name: "oxeye_daisy", Meta: 8},
+	}},
+	{ID: 39, Name: "minecraft:brown_mushroom", MaxStack: 64, Durability: 0, Block: world.BlockBrownMushroom, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 40, Name: "minecraft:red_mushroom", MaxStack: 64, Durability: 0, Block: world.BlockRedMushroom, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 41, Name: "minecraft:gold_block", MaxStack: 64, Durability: 0, Block: world.BlockGoldBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 42, Name: "minecraft:iron_block", MaxStack: 64, Durability: 0, Block: world.BlockIronBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 44, Name: "minecraft:stone_slab", MaxStack: 64, Durability: 0, Block: world.BlockStoneSlab, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "stone", Meta: 0},
+		{Damage: 1, Name: "sandstone", Meta: 1},
+		{Damage: 2, Name: "wood_old", Meta: 2},
+		{Damage: 3, Name: "cobblestone", Meta: 3},
+		{Damage: 4, Name: "brick", Meta: 4},
+		{Damage: 5, Name: "stone_brick", Meta: 5},
+		{Damage: 6, Name: "nether_brick", Meta: 6},
+		{Damage: 7, Name: "quartz", Meta: 7},
+	}},
+	{ID: 45, Name: "minecraft:brick_block", MaxStack: 64, Durability: 0, Block: world.BlockBrickBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 46, Name: "minecraft:tnt", MaxStack: 64, Durability: 0, Block: world.BlockTnt, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 47, Name: "minecraft:bookshelf", MaxStack: 64, Durability: 0, Block: world.BlockBookshelf, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 48, Name: "minecraft:mossy_cobblestone", MaxStack: 64, Durability: 0, Block: world.BlockMossyCobblestone, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 49, Name: "minecraft:obsidian", MaxStack: 64, Durability: 0, Block: world.BlockObsidian, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 50, Name: "minecraft:torch", MaxStack: 64, Durability: 0, Block: world.BlockTorch, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 1},
+	}},
+	{ID: 52, Name: "minecraft:mob_spawner", MaxStack: 64, Durability: 0, Block: world.BlockMobSpawner, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 53, Name: "minecraft:oak_stairs", MaxStack: 64, Durability: 0, Block: world.BlockOakStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 54, Name: "minecraft:chest", MaxStack: 64, Durability: 0, Block: world.BlockChest, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 2},
+	}},
+	{ID: 56, Name: "minecraft:diamond_ore", MaxStack: 64, Durability: 0, Block: world.BlockDiamondOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 57, Name: "minecraft:diamond_block", MaxStack: 64, Durability: 0, Block: world.BlockDiamondBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 58, Name: "minecraft:crafting_table", MaxStack: 64, Durability: 0, Block: world.BlockCraftingTable, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 60, Name: "minecraft:farmland", MaxStack: 64, Durability: 0, Block: world.BlockFarmland, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 61, Name: "minecraft:furnace", MaxStack: 64, Durability: 0, Block: world.BlockFurnace, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 2},
+	}},
+	{ID: 65, Name: "minecraft:ladder", MaxStack: 64, Durability: 0, Block: world.BlockLadder, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 2},
+	}},
+	{ID: 66, Name: "minecraft:rail", MaxStack: 64, Durability: 0, Block: world.BlockRail, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 67, Name: "minecraft:stone_stairs", MaxStack: 64, Durability: 0, Block: world.BlockStoneStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 69, Name: "minecraft:lever", MaxStack: 64, Durability: 0, Block: world.BlockLever, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 70, Name: "minecraft:stone_pressure_plate", MaxStack: 64, Durability: 0, Block: world.BlockStonePressurePlate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 72, Name: "minecraft:wooden_pressure_plate", MaxStack: 64, Durability: 0, Block: world.BlockWoodenPressurePlate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 73, Name: "minecraft:redstone_ore", MaxStack: 64, Durability: 0, Block: world.BlockRedstoneOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 76, Name: "minecraft:redstone_torch", MaxStack: 64, Durability: 0, Block: world.BlockRedstoneTorch, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 1},
+	}},
+	{ID: 77, Name: "minecraft:stone_button", MaxStack: 64, Durability: 0, Block: world.BlockStoneButton, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 78, Name: "minecraft:snow_layer", MaxStack: 64, Durability: 0, Block: world.BlockSnowLayer, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 79, Name: "minecraft:ice", MaxStack: 64, Durability: 0, Block: world.BlockIce, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 80, Name: "minecraft:snow", MaxStack: 64, Durability: 0, Block: world.BlockSnow, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 81, Name: "minecraft:cactus", MaxStack: 64, Durability: 0, Block: world.BlockCactus, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 82, Name: "minecraft:clay", MaxStack: 64, Durability: 0, Block: world.BlockClay, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 84, Name: "minecraft:jukebox", MaxStack: 64, Durability: 0, Block: world.BlockJukebox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 85, Name: "minecraft:fence", MaxStack: 64, Durability: 0, Block: world.BlockFence, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 86, Name: "minecraft:pumpkin", MaxStack: 64, Durability: 0, Block: world.BlockPumpkin, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 87, Name: "minecraft:netherrack", MaxStack: 64, Durability: 0, Block: world.BlockNetherrack, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 88, Name: "minecraft:soul_sand", MaxStack: 64, Durability: 0, Block: world.BlockSoulSand, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 89, Name: "minecraft:glowstone", MaxStack: 64, Durability: 0, Block: world.BlockGlowstone, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 91, Name: "minecraft:lit_pumpkin", MaxStack: 64, Durability: 0, Block: world.BlockLitPumpkin, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 95, Name: "minecraft:stained_glass", MaxStack: 64, Durability: 0, Block: world.BlockStainedGlass, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 1},
+		{Damage: 2, Name: "magenta", Meta: 2},
+		{Damage: 3, Name: "light_blue", Meta: 3},
+		{Damage: 4, Name: "yellow", Meta: 4},
+		{Damage: 5, Name: "lime", Meta: 5},
+		{Damage: 6, Name: "pink", Meta: 6},
+		{Damage: 7, Name: "gray", Meta: 7},
+		{Damage: 8, Name: "silver", Meta: 8},
+		{Damage: 9, Name: "cyan", Meta: 9},
+		{Damage: 10, Name: "purple", Meta: 10},
+		{Damage: 11, Name: "blue", Meta: 11},
+		{Damage: 12, Name: "brown", Meta: 12},
+		{Damage: 13, Name: "green", Meta: 13},
+		{Damage: 14, Name: "red", Meta: 14},
+		{Damage: 15, Name: "black", Meta: 15},
+	}},
+	{ID: 96, Name: "minecraft:trapdoor", MaxStack: 64, Durability: 0, Block: world.BlockTrapdoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 97, Name: "minecraft:monster_egg", MaxStack: 64, Durability: 0, Block: world.BlockMonsterEgg, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "stone", Meta: 0},
+		{Damage: 1, Name: "cobblestone", Meta: 1},
+		{Damage: 2, Name: "stone_brick", Meta: 2},
+		{Damage: 3, Name: "mossy_brick", Meta: 3},
+		{Damage: 4, Name: "cracked_brick", Meta: 4},
+		{Damage: 5, Name: "chiseled_brick", Meta: 5},
+	}},
+	{ID: 98, Name: "minecraft:stonebrick", MaxStack: 64, Durability: 0, Block: world.BlockStonebrick, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "stonebrick", Meta: 0},
+		{Damage: 1, Name: "mossy_stonebrick", Meta: 1},
+		{Damage: 2, Name: "cracked_stonebrick", Meta: 2},
+		{Damage: 3, Name: "chiseled_stonebrick", Meta: 3},
+	}},
+	{ID: 99, Name: "minecraft:brown_mushroom_block", MaxStack: 64, Durability: 0, Block: world.BlockBrownMushroomBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 100, Name: "minecraft:red_mushroom_block", MaxStack: 64, Durability: 0, Block: world.BlockRedMushroomBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 101, Name: "minecraft:iron_bars", MaxStack: 64, Durability: 0, Block: world.BlockIronBars, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 102, Name: "minecraft:glass_pane", MaxStack: 64, Durability: 0, Block: world.BlockGlassPane, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 103, Name: "minecraft:melon_block", MaxStack: 64, Durability: 0, Block: world.BlockMelonBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 106, Name: "minecraft:vine", MaxStack: 64, Durability: 0, Block: world.BlockVine, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 107, Name: "minecraft:fence_gate", MaxStack: 64, Durability: 0, Block: world.BlockFenceGate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 108, Name: "minecraft:brick_stairs", MaxStack: 64, Durability: 0, Block: world.BlockBrickStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 109, Name: "minecraft:stone_brick_stairs", MaxStack: 64, Durability: 0, Block: world.BlockStoneBrickStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 110, Name: "minecraft:mycelium", MaxStack: 64, Durability: 0, Block: world.BlockMycelium, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 111, Name: "minecraft:waterlily", MaxStack: 64, Durability: 0, Block: world.BlockWaterlily, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 112, Name: "minecraft:nether_brick", MaxStack: 64, Durability: 0, Block: world.BlockNetherBrick, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 113, Name: "minecraft:nether_brick_fence", MaxStack: 64, Durability: 0, Block: world.BlockNetherBrickFence, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 114, Name: "minecraft:nether_brick_stairs", MaxStack: 64, Durability: 0, Block: world.BlockNetherBrickStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 116, Name: "minecraft:enchanting_table", MaxStack: 64, Durability: 0, Block: world.BlockEnchantingTable, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 120, Name: "minecraft:end_portal_frame", MaxStack: 64, Durability: 0, Block: world.BlockEndPortalFrame, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 121, Name: "minecraft:end_stone", MaxStack: 64, Durability: 0, Block: world.BlockEndStone, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 122, Name: "minecraft:dragon_egg", MaxStack: 64, Durability: 0, Block: world.BlockDragonEgg, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 123, Name: "minecraft:redstone_lamp", MaxStack: 64, Durability: 0, Block: world.BlockRedstoneLamp, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 126, Name: "minecraft:wooden_slab", MaxStack: 64, Durability: 0, Block: world.BlockWoodenSlab, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "oak", Meta: 0},
+		{Damage: 1, Name: "spruce", Meta: 1},
+		{Damage: 2, Name: "birch", Meta: 2},
+		{Damage: 3, Name: "jungle", Meta: 3},
+		{Damage: 4, Name: "acacia", Meta: 4},
+		{Damage: 5, Name: "dark_oak", Meta: 5},
+	}},
+	{ID: 128, Name: "minecraft:sandstone_stairs", MaxStack: 64, Durability: 0, Block: world.BlockSandstoneStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 129, Name: "minecraft:emerald_ore", MaxStack: 64, Durability: 0, Block: world.BlockEmeraldOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 130, Name: "minecraft:ender_chest", MaxStack: 64, Durability: 0, Block: world.BlockEnderChest, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 2},
+	}},
+	{ID: 131, Name: "minecraft:tripwire_hook", MaxStack: 64, Durability: 0, Block: world.BlockTripwireHook, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 133, Name: "minecraft:emerald_block", MaxStack: 64, Durability: 0, Block: world.BlockEmeraldBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 134, Name: "minecraft:spruce_stairs", MaxStack: 64, Durability: 0, Block: world.BlockSpruceStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 135, Name: "minecraft:birch_stairs", MaxStack: 64, Durability: 0, Block: world.BlockBirchStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 136, Name: "minecraft:jungle_stairs", MaxStack: 64, Durability: 0, Block: world.BlockJungleStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 137, Name: "minecraft:command_block", MaxStack: 64, Durability: 0, Block: world.BlockCommandBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 138, Name: "minecraft:beacon", MaxStack: 64, Durability: 0, Block: world.BlockBeacon, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 139, Name: "minecraft:cobblestone_wall", MaxStack: 64, Durability: 0, Block: world.BlockCobblestoneWall, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "cobblestone", Meta: 0},
+		{Damage: 1, Name: "mossy_cobblestone", Meta: 1},
+	}},
+	{ID: 143, Name: "minecraft:wooden_button", MaxStack: 64, Durability: 0, Block: world.BlockWoodenButton, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 145, Name: "minecraft:anvil", MaxStack: 64, Durability: 0, Block: world.BlockAnvil, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "anvil", Meta: 0},
+		{Damage: 1, Name: "slightly_damaged", Meta: 4},
+		{Damage: 2, Name: "very_damaged", Meta: 8},
+	}},
+	{ID: 146, Name: "minecraft:trapped_chest", MaxStack: 64, Durability: 0, Block: world.BlockTrappedChest, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 2},
+	}},
+	{ID: 147, Name: "minecraft:light_weighted_pressure_plate", MaxStack: 64, Durability: 0, Block: world.BlockLightWeightedPressurePlate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 148, Name: "minecraft:heavy_weighted_pressure_plate", MaxStack: 64, Durability: 0, Block: world.BlockHeavyWeightedPressurePlate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 151, Name: "minecraft:daylight_detector", MaxStack: 64, Durability: 0, Block: world.BlockDaylightDetector, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 152, Name: "minecraft:redstone_block", MaxStack: 64, Durability: 0, Block: world.BlockRedstoneBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 153, Name: "minecraft:quartz_ore", MaxStack: 64, Durability: 0, Block: world.BlockQuartzOre, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 154, Name: "minecraft:hopper", MaxStack: 64, Durability: 0, Block: world.BlockHopper, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 155, Name: "minecraft:quartz_block", MaxStack: 64, Durability: 0, Block: world.BlockQuartzBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "default", Meta: 0},
+		{Damage: 1, Name: "chiseled", Meta: 1},
+		{Damage: 2, Name: "lines_y", Meta: 2},
+	}},
+	{ID: 156, Name: "minecraft:quartz_stairs", MaxStack: 64, Durability: 0, Block: world.BlockQuartzStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 157, Name: "minecraft:activator_rail", MaxStack: 64, Durability: 0, Block: world.BlockActivatorRail, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 158, Name: "minecraft:dropper", MaxStack: 64, Durability: 0, Block: world.BlockDropper, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 159, Name: "minecraft:stained_hardened_clay", MaxStack: 64, Durability: 0, Block: world.BlockStainedHardenedClay, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 1},
+		{Damage: 2, Name: "magenta", Meta: 2},
+		{Damage: 3, Name: "light_blue", Meta: 3},
+		{Damage: 4, Name: "yellow", Meta: 4},
+		{Damage: 5, Name: "lime", Meta: 5},
+		{Damage: 6, Name: "pink", Meta: 6},
+		{Damage: 7, Name: "gray", Meta: 7},
+		{Damage: 8, Name: "silver", Meta: 8},
+		{Damage: 9, Name: "cyan", Meta: 9},
+		{Damage: 10, Name: "purple", Meta: 10},
+		{Damage: 11, Name: "blue", Meta: 11},
+		{Damage: 12, Name: "brown", Meta: 12},
+		{Damage: 13, Name: "green", Meta: 13},
+		{Damage: 14, Name: "red", Meta: 14},
+		{Damage: 15, Name: "black", Meta: 15},
+	}},
+	{ID: 160, Name: "minecraft:stained_glass_pane", MaxStack: 64, Durability: 0, Block: world.BlockStainedGlassPane, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 1},
+		{Damage: 2, Name: "magenta", Meta: 2},
+		{Damage: 3, Name: "light_blue", Meta: 3},
+		{Damage: 4, Name: "yellow", Meta: 4},
+		{Damage: 5, Name: "lime", Meta: 5},
+		{Damage: 6, Name: "pink", Meta: 6},
+		{Damage: 7, Name: "gray", Meta: 7},
+		{Damage: 8, Name: "silver", Meta: 8},
+		{Damage: 9, Name: "cyan", Meta: 9},
+		{Damage: 10, Name: "purple", Meta: 10},
+		{Damage: 11, Name: "blue", Meta: 11},
+		{Damage: 12, Name: "brown", Meta: 12},
+		{Damage: 13, Name: "green", Meta: 13},
+		{Damage: 14, Name: "red", Meta: 14},
+		{Damage: 15, Name: "black", Meta: 15},
+	}},
+	{ID: 161, Name: "minecraft:leaves2", MaxStack: 64, Durability: 0, Block: world.BlockLeaves2, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "acacia", Meta: 0},
+		{Damage: 1, Name: "dark_oak", Meta: 1},
+	}},
+	{ID: 162, Name: "minecraft:log2", MaxStack: 64, Durability: 0, Block: world.BlockLog2, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "acacia", Meta: 0},
+		{Damage: 1, Name: "dark_oak", Meta: 1},
+	}},
+	{ID: 163, Name: "minecraft:acacia_stairs", MaxStack: 64, Durability: 0, Block: world.BlockAcaciaStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 164, Name: "minecraft:dark_oak_stairs", MaxStack: 64, Durability: 0, Block: world.BlockDarkOakStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 165, Name: "minecraft:slime", MaxStack: 64, Durability: 0, Block: world.BlockSlime, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 166, Name: "minecraft:barrier", MaxStack: 64, Durability: 0, Block: world.BlockBarrier, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 167, Name: "minecraft:iron_trapdoor", MaxStack: 64, Durability: 0, Block: world.BlockIronTrapdoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 168, Name: "minecraft:prismarine", MaxStack: 64, Durability: 0, Block: world.BlockPrismarine, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "prismarine", Meta: 0},
+		{Damage: 1, Name: "prismarine_bricks", Meta: 1},
+		{Damage: 2, Name: "dark_prismarine", Meta: 2},
+	}},
+	{ID: 169, Name: "minecraft:sea_lantern", MaxStack: 64, Durability: 0, Block: world.BlockSeaLantern, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 170, Name: "minecraft:hay_block", MaxStack: 64, Durability: 0, Block: world.BlockHayBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 171, Name: "minecraft:carpet", MaxStack: 64, Durability: 0, Block: world.BlockCarpet, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 1},
+		{Damage: 2, Name: "magenta", Meta: 2},
+		{Damage: 3, Name: "light_blue", Meta: 3},
+		{Damage: 4, Name: "yellow", Meta: 4},
+		{Damage: 5, Name: "lime", Meta: 5},
+		{Damage: 6, Name: "pink", Meta: 6},
+		{Damage: 7, Name: "gray", Meta: 7},
+		{Damage: 8, Name: "silver", Meta: 8},
+		{Damage: 9, Name: "cyan", Meta: 9},
+		{Damage: 10, Name: "purple", Meta: 10},
+		{Damage: 11, Name: "blue", Meta: 11},
+		{Damage: 12, Name: "brown", Meta: 12},
+		{Damage: 13, Name: "green", Meta: 13},
+		{Damage: 14, Name: "red", Meta: 14},
+		{Damage: 15, Name: "black", Meta: 15},
+	}},
+	{ID: 172, Name: "minecraft:hardened_clay", MaxStack: 64, Durability: 0, Block: world.BlockHardenedClay, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 173, Name: "minecraft:coal_block", MaxStack: 64, Durability: 0, Block: world.BlockCoalBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 174, Name: "minecraft:packed_ice", MaxStack: 64, Durability: 0, Block: world.BlockPackedIce, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 175, Name: "minecraft:double_plant", MaxStack: 64, Durability: 0, Block: world.BlockDoublePlant, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "sunflower", Meta: 0},
+		{Damage: 1, Name: "syringa", Meta: 1},
+		{Damage: 2, Name: "double_grass", Meta: 2},
+		{Damage: 3, Name: "double_fern", Meta: 3},
+		{Damage: 4, Name: "double_rose", Meta: 4},
+		{Damage: 5, Name: "paeonia", Meta: 5},
+	}},
+	{ID: 179, Name: "minecraft:red_sandstone", MaxStack: 64, Durability: 0, Block: world.BlockRedSandstone, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "red_sandstone", Meta: 0},
+		{Damage: 1, Name: "chiseled_red_sandstone", Meta: 1},
+		{Damage: 2, Name: "smooth_red_sandstone", Meta: 2},
+	}},
+	{ID: 180, Name: "minecraft:red_sandstone_stairs", MaxStack: 64, Durability: 0, Block: world.BlockRedSandstoneStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 182, Name: "minecraft:stone_slab2", MaxStack: 64, Durability: 0, Block: world.BlockStoneSlab2, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 183, Name: "minecraft:spruce_fence_gate", MaxStack: 64, Durability: 0, Block: world.BlockSpruceFenceGate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 184, Name: "minecraft:birch_fence_gate", MaxStack: 64, Durability: 0, Block: world.BlockBirchFenceGate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 185, Name: "minecraft:jungle_fence_gate", MaxStack: 64, Durability: 0, Block: world.BlockJungleFenceGate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 186, Name: "minecraft:dark_oak_fence_gate", MaxStack: 64, Durability: 0, Block: world.BlockDarkOakFenceGate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 187, Name: "minecraft:acacia_fence_gate", MaxStack: 64, Durability: 0, Block: world.BlockAcaciaFenceGate, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 188, Name: "minecraft:spruce_fence", MaxStack: 64, Durability: 0, Block: world.BlockSpruceFence, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 189, Name: "minecraft:birch_fence", MaxStack: 64, Durability: 0, Block: world.BlockBirchFence, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 190, Name: "minecraft:jungle_fence", MaxStack: 64, Durability: 0, Block: world.BlockJungleFence, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 191, Name: "minecraft:dark_oak_fence", MaxStack: 64, Durability: 0, Block: world.BlockDarkOakFence, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 192, Name: "minecraft:acacia_fence", MaxStack: 64, Durability: 0, Block: world.BlockAcaciaFence, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 198, Name: "minecraft:end_rod", MaxStack: 64, Durability: 0, Block: world.BlockEndRod, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 199, Name: "minecraft:chorus_plant", MaxStack: 64, Durability: 0, Block: world.BlockChorusPlant, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 200, Name: "minecraft:chorus_flower", MaxStack: 64, Durability: 0, Block: world.BlockChorusFlower, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 201, Name: "minecraft:purpur_block", MaxStack: 64, Durability: 0, Block: world.BlockPurpurBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 202, Name: "minecraft:purpur_pillar", MaxStack: 64, Durability: 0, Block: world.BlockPurpurPillar, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 203, Name: "minecraft:purpur_stairs", MaxStack: 64, Durability: 0, Block: world.BlockPurpurStairs, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 205, Name: "minecraft:purpur_slab", MaxStack: 64, Durability: 0, Block: world.BlockPurpurSlab, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 206, Name: "minecraft:end_bricks", MaxStack: 64, Durability: 0, Block: world.BlockEndBricks, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 208, Name: "minecraft:grass_path", MaxStack: 64, Durability: 0, Block: world.BlockGrassPath, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 210, Name: "minecraft:repeating_command_block", MaxStack: 64, Durability: 0, Block: world.BlockRepeatingCommandBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 211, Name: "minecraft:chain_command_block", MaxStack: 64, Durability: 0, Block: world.BlockChainCommandBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 213, Name: "minecraft:magma", MaxStack: 64, Durability: 0, Block: world.BlockMagma, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 214, Name: "minecraft:nether_wart_block", MaxStack: 64, Durability: 0, Block: world.BlockNetherWartBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 215, Name: "minecraft:red_nether_brick", MaxStack: 64, Durability: 0, Block: world.BlockRedNetherBrick, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 216, Name: "minecraft:bone_block", MaxStack: 64, Durability: 0, Block: world.BlockBoneBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 217, Name: "minecraft:structure_void", MaxStack: 64, Durability: 0, Block: world.BlockStructureVoid, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 218, Name: "minecraft:observer", MaxStack: 64, Durability: 0, Block: world.BlockObserver, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 219, Name: "minecraft:white_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockWhiteShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 220, Name: "minecraft:orange_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockOrangeShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 221, Name: "minecraft:magenta_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockMagentaShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 222, Name: "minecraft:light_blue_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockLightBlueShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 223, Name: "minecraft:yellow_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockYellowShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 224, Name: "minecraft:lime_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockLimeShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 225, Name: "minecraft:pink_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockPinkShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 226, Name: "minecraft:gray_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockGrayShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 227, Name: "minecraft:silver_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockSilverShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 228, Name: "minecraft:cyan_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockCyanShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 229, Name: "minecraft:purple_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockPurpleShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 230, Name: "minecraft:blue_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockBlueShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 231, Name: "minecraft:brown_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockBrownShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 232, Name: "minecraft:green_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockGreenShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 233, Name: "minecraft:red_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockRedShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 234, Name: "minecraft:black_shulker_box", MaxStack: 64, Durability: 0, Block: world.BlockBlackShulkerBox, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 235, Name: "minecraft:white_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockWhiteGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 236, Name: "minecraft:orange_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockOrangeGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 237, Name: "minecraft:magenta_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockMagentaGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 238, Name: "minecraft:light_blue_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockLightBlueGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 239, Name: "minecraft:yellow_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockYellowGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 240, Name: "minecraft:lime_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockLimeGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 241, Name: "minecraft:pink_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockPinkGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 242, Name: "minecraft:gray_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockGrayGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 243, Name: "minecraft:silver_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockSilverGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 244, Name: "minecraft:cyan_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockCyanGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 245, Name: "minecraft:purple_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockPurpleGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 246, Name: "minecraft:blue_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockBlueGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 247, Name: "minecraft:brown_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockBrownGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 248, Name: "minecraft:green_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockGreenGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 249, Name: "minecraft:red_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockRedGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 250, Name: "minecraft:black_glazed_terracotta", MaxStack: 64, Durability: 0, Block: world.BlockBlackGlazedTerracotta, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 251, Name: "minecraft:concrete", MaxStack: 64, Durability: 0, Block: world.BlockConcrete, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 1},
+		{Damage: 2, Name: "magenta", Meta: 2},
+		{Damage: 3, Name: "light_blue", Meta: 3},
+		{Damage: 4, Name: "yellow", Meta: 4},
+		{Damage: 5, Name: "lime", Meta: 5},
+		{Damage: 6, Name: "pink", Meta: 6},
+		{Damage: 7, Name: "gray", Meta: 7},
+		{Damage: 8, Name: "silver", Meta: 8},
+		{Damage: 9, Name: "cyan", Meta: 9},
+		{Damage: 10, Name: "purple", Meta: 10},
+		{Damage: 11, Name: "blue", Meta: 11},
+		{Damage: 12, Name: "brown", Meta: 12},
+		{Damage: 13, Name: "green", Meta: 13},
+		{Damage: 14, Name: "red", Meta: 14},
+		{Damage: 15, Name: "black", Meta: 15},
+	}},
+	{ID: 252, Name: "minecraft:concrete_powder", MaxStack: 64, Durability: 0, Block: world.BlockConcretePowder, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 1},
+		{Damage: 2, Name: "magenta", Meta: 2},
+		{Damage: 3, Name: "light_blue", Meta: 3},
+		{Damage: 4, Name: "yellow", Meta: 4},
+		{Damage: 5, Name: "lime", Meta: 5},
+		{Damage: 6, Name: "pink", Meta: 6},
+		{Damage: 7, Name: "gray", Meta: 7},
+		{Damage: 8, Name: "silver", Meta: 8},
+		{Damage: 9, Name: "cyan", Meta: 9},
+		{Damage: 10, Name: "purple", Meta: 10},
+		{Damage: 11, Name: "blue", Meta: 11},
+		{Damage: 12, Name: "brown", Meta: 12},
+		{Damage: 13, Name: "green", Meta: 13},
+		{Damage: 14, Name: "red", Meta: 14},
+		{Damage: 15, Name: "black", Meta: 15},
+	}},
+	{ID: 255, Name: "minecraft:structure_block", MaxStack: 64, Durability: 0, Block: world.BlockStructureBlock, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 256, Name: "minecraft:iron_shovel", MaxStack: 1, Durability: 250, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 257, Name: "minecraft:iron_pickaxe", MaxStack: 1, Durability: 250, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 258, Name: "minecraft:iron_axe", MaxStack: 1, Durability: 250, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 259, Name: "minecraft:flint_and_steel", MaxStack: 1, Durability: 64, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 260, Name: "minecraft:apple", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 261, Name: "minecraft:bow", MaxStack: 1, Durability: 384, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 262, Name: "minecraft:arrow", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 263, Name: "minecraft:coal", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "coal", Meta: 0},
+		{Damage: 1, Name: "charcoal", Meta: 0},
+	}},
+	{ID: 264, Name: "minecraft:diamond", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 265, Name: "minecraft:iron_ingot", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 266, Name: "minecraft:gold_ingot", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 267, Name: "minecraft:iron_sword", MaxStack: 1, Durability: 250, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 268, Name: "minecraft:wooden_sword", MaxStack: 1, Durability: 59, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 269, Name: "minecraft:wooden_shovel", MaxStack: 1, Durability: 59, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 270, Name: "minecraft:wooden_pickaxe", MaxStack: 1, Durability: 59, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 271, Name: "minecraft:wooden_axe", MaxStack: 1, Durability: 59, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 272, Name: "minecraft:stone_sword", MaxStack: 1, Durability: 131, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 273, Name: "minecraft:stone_shovel", MaxStack: 1, Durability: 131, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 274, Name: "minecraft:stone_pickaxe", MaxStack: 1, Durability: 131, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 275, Name: "minecraft:stone_axe", MaxStack: 1, Durability: 131, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 276, Name: "minecraft:diamond_sword", MaxStack: 1, Durability: 1561, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 277, Name: "minecraft:diamond_shovel", MaxStack: 1, Durability: 1561, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 278, Name: "minecraft:diamond_pickaxe", MaxStack: 1, Durability: 1561, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 279, Name: "minecraft:diamond_axe", MaxStack: 1, Durability: 1561, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 280, Name: "minecraft:stick", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 281, Name: "minecraft:bowl", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 282, Name: "minecraft:mushroom_stew", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 283, Name: "minecraft:golden_sword", MaxStack: 1, Durability: 32, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 284, Name: "minecraft:golden_shovel", MaxStack: 1, Durability: 32, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 285, Name: "minecraft:golden_pickaxe", MaxStack: 1, Durability: 32, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 286, Name: "minecraft:golden_axe", MaxStack: 1, Durability: 32, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 287, Name: "minecraft:string", MaxStack: 64, Durability: 0, Block: world.BlockTripwire, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 288, Name: "minecraft:feather", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 289, Name: "minecraft:gunpowder", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 290, Name: "minecraft:wooden_hoe", MaxStack: 1, Durability: 59, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 291, Name: "minecraft:stone_hoe", MaxStack: 1, Durability: 131, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 292, Name: "minecraft:iron_hoe", MaxStack: 1, Durability: 250, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 293, Name: "minecraft:diamond_hoe", MaxStack: 1, Durability: 1561, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 294, Name: "minecraft:golden_hoe", MaxStack: 1, Durability: 32, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 295, Name: "minecraft:wheat_seeds", MaxStack: 64, Durability: 0, Block: world.BlockWheat, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 296, Name: "minecraft:wheat", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 297, Name: "minecraft:bread", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 298, Name: "minecraft:leather_helmet", MaxStack: 1, Durability: 55, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 299, Name: "minecraft:leather_chestplate", MaxStack: 1, Durability: 80, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 300, Name: "minecraft:leather_leggings", MaxStack: 1, Durability: 75, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 301, Name: "minecraft:leather_boots", MaxStack: 1, Durability: 65, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 302, Name: "minecraft:chainmail_helmet", MaxStack: 1, Durability: 165, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 303, Name: "minecraft:chainmail_chestplate", MaxStack: 1, Durability: 240, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 304, Name: "minecraft:chainmail_leggings", MaxStack: 1, Durability: 225, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 305, Name: "minecraft:chainmail_boots", MaxStack: 1, Durability: 195, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 306, Name: "minecraft:iron_helmet", MaxStack: 1, Durability: 165, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 307, Name: "minecraft:iron_chestplate", MaxStack: 1, Durability: 240, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 308, Name: "minecraft:iron_leggings", MaxStack: 1, Durability: 225, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 309, Name: "minecraft:iron_boots", MaxStack: 1, Durability: 195, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 310, Name: "minecraft:diamond_helmet", MaxStack: 1, Durability: 363, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 311, Name: "minecraft:diamond_chestplate", MaxStack: 1, Durability: 528, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 312, Name: "minecraft:diamond_leggings", MaxStack: 1, Durability: 495, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 313, Name: "minecraft:diamond_boots", MaxStack: 1, Durability: 429, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 314, Name: "minecraft:golden_helmet", MaxStack: 1, Durability: 77, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 315, Name: "minecraft:golden_chestplate", MaxStack: 1, Durability: 112, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 316, Name: "minecraft:golden_leggings", MaxStack: 1, Durability: 105, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 317, Name: "minecraft:golden_boots", MaxStack: 1, Durability: 91, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 318, Name: "minecraft:flint", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 319, Name: "minecraft:porkchop", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 320, Name: "minecraft:cooked_porkchop", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 321, Name: "minecraft:painting", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 322, Name: "minecraft:golden_apple", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "golden_apple", Meta: 0},
+		{Damage: 1, Name: "enchanted_golden_apple", Meta: 0},
+	}},
+	{ID: 323, Name: "minecraft:sign", MaxStack: 16, Durability: 0, Block: world.BlockStandingSign, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 324, Name: "minecraft:wooden_door", MaxStack: 64, Durability: 0, Block: world.BlockWoodenDoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 325, Name: "minecraft:bucket", MaxStack: 16, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 326, Name: "minecraft:water_bucket", MaxStack: 1, Durability: 0, Block: world.BlockFlowingWater, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 327, Name: "minecraft:lava_bucket", MaxStack: 1, Durability: 0, Block: world.BlockFlowingLava, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 328, Name: "minecraft:minecart", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 329, Name: "minecraft:saddle", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 330, Name: "minecraft:iron_door", MaxStack: 64, Durability: 0, Block: world.BlockIronDoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 331, Name: "minecraft:redstone", MaxStack: 64, Durability: 0, Block: world.BlockRedstoneWire, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 332, Name: "minecraft:snowball", MaxStack: 16, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 333, Name: "minecraft:boat", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 334, Name: "minecraft:leather", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 335, Name: "minecraft:milk_bucket", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 336, Name: "minecraft:brick", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 337, Name: "minecraft:clay_ball", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 338, Name: "minecraft:reeds", MaxStack: 64, Durability: 0, Block: world.BlockReeds, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 339, Name: "minecraft:paper", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 340, Name: "minecraft:book", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 341, Name: "minecraft:slime_ball", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 342, Name: "minecraft:chest_minecart", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 343, Name: "minecraft:furnace_minecart", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 344, Name: "minecraft:egg", MaxStack: 16, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 345, Name: "minecraft:compass", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 346, Name: "minecraft:fishing_rod", MaxStack: 1, Durability: 64, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 347, Name: "minecraft:clock", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 348, Name: "minecraft:glowstone_dust", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 349, Name: "minecraft:fish", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "cod", Meta: 0},
+		{Damage: 1, Name: "salmon", Meta: 0},
+		{Damage: 2, Name: "clownfish", Meta: 0},
+		{Damage: 3, Name: "pufferfish", Meta: 0},
+	}},
+	{ID: 350, Name: "minecraft:cooked_fish", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "cod", Meta: 0},
+		{Damage: 1, Name: "salmon", Meta: 0},
+	}},
+	{ID: 351, Name: "minecraft:dye", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "black", Meta: 0},
+		{Damage: 1, Name: "red", Meta: 0},
+		{Damage: 2, Name: "green", Meta: 0},
+		{Damage: 3, Name: "brown", Meta: 0},
+		{Damage: 4, Name: "blue", Meta: 0},
+		{Damage: 5, Name: "purple", Meta: 0},
+		{Damage: 6, Name: "cyan", Meta: 0},
+		{Damage: 7, Name: "silver", Meta: 0},
+		{Damage: 8, Name: "gray", Meta: 0},
+		{Damage: 9, Name: "pink", Meta: 0},
+		{Damage: 10, Name: "lime", Meta: 0},
+		{Damage: 11, Name: "yellow", Meta: 0},
+		{Damage: 12, Name: "light_blue", Meta: 0},
+		{Damage: 13, Name: "magenta", Meta: 0},
+		{Damage: 14, Name: "orange", Meta: 0},
+		{Damage: 15, Name: "white", Meta: 0},
+	}},
+	{ID: 352, Name: "minecraft:bone", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 353, Name: "minecraft:sugar", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 354, Name: "minecraft:cake", MaxStack: 1, Durability: 0, Block: world.BlockCake, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 355, Name: "minecraft:bed", MaxStack: 1, Durability: 0, Block: world.BlockBed, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "white", Meta: 0},
+		{Damage: 1, Name: "orange", Meta: 0},
+		{Damage: 2, Name: "magenta", Meta: 0},
+		{Damage: 3, Name: "light_blue", Meta: 0},
+		{Damage: 4, Name: "yellow", Meta: 0},
+		{Damage: 5, Name: "lime", Meta: 0},
+		{Damage: 6, Name: "pink", Meta: 0},
+		{Damage: 7, Name: "gray", Meta: 0},
+		{Damage: 8, Name: "silver", Meta: 0},
+		{Damage: 9, Name: "cyan", Meta: 0},
+		{Damage: 10, Name: "purple", Meta: 0},
+		{Damage: 11, Name: "blue", Meta: 0},
+		{Damage: 12, Name: "brown", Meta: 0},
+		{Damage: 13, Name: "green", Meta: 0},
+		{Damage: 14, Name: "red", Meta: 0},
+		{Damage: 15, Name: "black", Meta: 0},
+	}},
+	{ID: 356, Name: "minecraft:repeater", MaxStack: 64, Durability: 0, Block: world.BlockUnpoweredRepeater, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 357, Name: "minecraft:cookie", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 358, Name: "minecraft:filled_map", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 359, Name: "minecraft:shears", MaxStack: 1, Durability: 238, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 360, Name: "minecraft:melon", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 361, Name: "minecraft:pumpkin_seeds", MaxStack: 64, Durability: 0, Block: world.BlockPumpkinStem, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 362, Name: "minecraft:melon_seeds", MaxStack: 64, Durability: 0, Block: world.BlockMelonStem, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 363, Name: "minecraft:beef", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 364, Name: "minecraft:cooked_beef", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 365, Name: "minecraft:chicken", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 366, Name: "minecraft:cooked_chicken", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 367, Name: "minecraft:rotten_flesh", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 368, Name: "minecraft:ender_pearl", MaxStack: 16, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 369, Name: "minecraft:blaze_rod", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 370, Name: "minecraft:ghast_tear", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 371, Name: "minecraft:gold_nugget", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 372, Name: "minecraft:nether_wart", MaxStack: 64, Durability: 0, Block: world.BlockNetherWart, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 373, Name: "minecraft:potion", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 374, Name: "minecraft:glass_bottle", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 375, Name: "minecraft:spider_eye", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 376, Name: "minecraft:fermented_spider_eye", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 377, Name: "minecraft:blaze_powder", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 378, Name: "minecraft:magma_cream", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 379, Name: "minecraft:brewing_stand", MaxStack: 64, Durability: 0, Block: world.BlockBrewingStand, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 380, Name: "minecraft:cauldron", MaxStack: 64, Durability: 0, Block: world.BlockCauldron, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 381, Name: "minecraft:ender_eye", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 382, Name: "minecraft:speckled_melon", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 383, Name: "minecraft:spawn_egg", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 384, Name: "minecraft:experience_bottle", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 385, Name: "minecraft:fire_charge", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 386, Name: "minecraft:writable_book", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 387, Name: "minecraft:written_book", MaxStack: 16, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 388, Name: "minecraft:emerald", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 389, Name: "minecraft:item_frame", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 390, Name: "minecraft:flower_pot", MaxStack: 64, Durability: 0, Block: world.BlockFlowerPot, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 391, Name: "minecraft:carrot", MaxStack: 64, Durability: 0, Block: world.BlockCarrots, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 392, Name: "minecraft:potato", MaxStack: 64, Durability: 0, Block: world.BlockPotatoes, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 393, Name: "minecraft:baked_potato", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 394, Name: "minecraft:poisonous_potato", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 395, Name: "minecraft:map", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 396, Name: "minecraft:golden_carrot", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 397, Name: "minecraft:skull", MaxStack: 64, Durability: 0, Block: world.BlockSkull, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "skeleton", Meta: 1},
+		{Damage: 1, Name: "wither_skeleton", Meta: 1},
+		{Damage: 2, Name: "zombie", Meta: 1},
+		{Damage: 3, Name: "player", Meta: 1},
+		{Damage: 4, Name: "creeper", Meta: 1},
+		{Damage: 5, Name: "dragon", Meta: 1},
+	}},
+	{ID: 398, Name: "minecraft:carrot_on_a_stick", MaxStack: 1, Durability: 25, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 399, Name: "minecraft:nether_star", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 400, Name: "minecraft:pumpkin_pie", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 401, Name: "minecraft:fireworks", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 402, Name: "minecraft:firework_charge", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 403, Name: "minecraft:enchanted_book", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 404, Name: "minecraft:comparator", MaxStack: 64, Durability: 0, Block: world.BlockUnpoweredComparator, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 405, Name: "minecraft:netherbrick", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 406, Name: "minecraft:quartz", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 407, Name: "minecraft:tnt_minecart", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 408, Name: "minecraft:hopper_minecart", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 409, Name: "minecraft:prismarine_shard", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 410, Name: "minecraft:prismarine_crystals", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 411, Name: "minecraft:rabbit", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 412, Name: "minecraft:cooked_rabbit", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 413, Name: "minecraft:rabbit_stew", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 414, Name: "minecraft:rabbit_foot", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 415, Name: "minecraft:rabbit_hide", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 416, Name: "minecraft:armor_stand", MaxStack: 16, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 417, Name: "minecraft:iron_horse_armor", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 418, Name: "minecraft:golden_horse_armor", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 419, Name: "minecraft:diamond_horse_armor", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 420, Name: "minecraft:lead", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 421, Name: "minecraft:name_tag", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 422, Name: "minecraft:command_block_minecart", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 423, Name: "minecraft:mutton", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 424, Name: "minecraft:cooked_mutton", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 425, Name: "minecraft:banner", MaxStack: 16, Durability: 0, Block: world.BlockStandingBanner, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "black", Meta: 0},
+		{Damage: 1, Name: "red", Meta: 0},
+		{Damage: 2, Name: "green", Meta: 0},
+		{Damage: 3, Name: "brown", Meta: 0},
+		{Damage: 4, Name: "blue", Meta: 0},
+		{Damage: 5, Name: "purple", Meta: 0},
+		{Damage: 6, Name: "cyan", Meta: 0},
+		{Damage: 7, Name: "silver", Meta: 0},
+		{Damage: 8, Name: "gray", Meta: 0},
+		{Damage: 9, Name: "pink", Meta: 0},
+		{Damage: 10, Name: "lime", Meta: 0},
+		{Damage: 11, Name: "yellow", Meta: 0},
+		{Damage: 12, Name: "light_blue", Meta: 0},
+		{Damage: 13, Name: "magenta", Meta: 0},
+		{Damage: 14, Name: "orange", Meta: 0},
+		{Damage: 15, Name: "white", Meta: 0},
+	}},
+	{ID: 426, Name: "minecraft:end_crystal", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 427, Name: "minecraft:spruce_door", MaxStack: 64, Durability: 0, Block: world.BlockSpruceDoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 428, Name: "minecraft:birch_door", MaxStack: 64, Durability: 0, Block: world.BlockBirchDoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 429, Name: "minecraft:jungle_door", MaxStack: 64, Durability: 0, Block: world.BlockJungleDoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 430, Name: "minecraft:acacia_door", MaxStack: 64, Durability: 0, Block: world.BlockAcaciaDoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 431, Name: "minecraft:dark_oak_door", MaxStack: 64, Durability: 0, Block: world.BlockDarkOakDoor, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 432, Name: "minecraft:chorus_fruit", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 433, Name: "minecraft:chorus_fruit_popped", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 434, Name: "minecraft:beetroot", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 435, Name: "minecraft:beetroot_seeds", MaxStack: 64, Durability: 0, Block: world.BlockBeetroots, PlacesBlock: true, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 436, Name: "minecraft:beetroot_soup", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 437, Name: "minecraft:dragon_breath", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 438, Name: "minecraft:splash_potion", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 439, Name: "minecraft:spectral_arrow", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 440, Name: "minecraft:tipped_arrow", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 441, Name: "minecraft:lingering_potion", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 442, Name: "minecraft:shield", MaxStack: 1, Durability: 336, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 443, Name: "minecraft:elytra", MaxStack: 1, Durability: 432, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 444, Name: "minecraft:spruce_boat", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 445, Name: "minecraft:birch_boat", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 446, Name: "minecraft:jungle_boat", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 447, Name: "minecraft:acacia_boat", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 448, Name: "minecraft:dark_oak_boat", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 449, Name: "minecraft:totem_of_undying", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 450, Name: "minecraft:shulker_shell", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 452, Name: "minecraft:iron_nugget", MaxStack: 64, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 453, Name: "minecraft:knowledge_book", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2256, Name: "minecraft:record_13", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2257, Name: "minecraft:record_cat", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2258, Name: "minecraft:record_blocks", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2259, Name: "minecraft:record_chirp", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2260, Name: "minecraft:record_far", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2261, Name: "minecraft:record_mall", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2262, Name: "minecraft:record_mellohi", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2263, Name: "minecraft:record_stal", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2264, Name: "minecraft:record_strad", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2265, Name: "minecraft:record_ward", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2266, Name: "minecraft:record_11", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+	{ID: 2267, Name: "minecraft:record_wait", MaxStack: 1, Durability: 0, Variants: []Variant{
+		{Damage: 0, Name: "", Meta: 0},
+	}},
+}
@@ -0,0 +1,129 @@
+package item
+
+import (
+	"github.com/laushunyu/real/stream"
+	"github.com/seebs/nbt"
+)
+
+// Enchantment ids of 1.12.
+type Enchantment int16
+
+const (
+	EnchantProtection           Enchantment = 0
+	EnchantFireProtection       Enchantment = 1
+	EnchantFeatherFalling       Enchantment = 2
+	EnchantBlastProtection      Enchantment = 3
+	EnchantProjectileProtection Enchantment = 4
+	EnchantRespiration          Enchantment = 5
+	EnchantAquaAffinity         Enchantment = 6
+	EnchantThorns               Enchantment = 7
+	EnchantDepthStrider         Enchantment = 8
+	EnchantFrostWalker          Enchantment = 9
+	EnchantBindingCurse         Enchantment = 10
+	EnchantSharpness            Enchantment = 16
+	EnchantSmite                Enchantment = 17
+	EnchantBaneOfArthropods     Enchantment = 18
+	EnchantKnockback            Enchantment = 19
+	EnchantFireAspect           Enchantment = 20
+	EnchantLooting              Enchantment = 21
+	EnchantSweeping             Enchantment = 22
+	EnchantEfficiency           Enchantment = 32
+	EnchantSilkTouch            Enchantment = 33
+	EnchantUnbreaking           Enchantment = 34
+	EnchantFortune              Enchantment = 35
+	EnchantPower                Enchantment = 48
+	EnchantPunch                Enchantment = 49
+	EnchantFlame                Enchantment = 50
+	EnchantInfinity             Enchantment = 51
+	EnchantLuckOfTheSea         Enchantment = 61
+	EnchantLure                 Enchantment = 62
+	EnchantMending              Enchantment = 70
+	EnchantVanishingCurse       Enchantment = 71
+)
+
+// Stack builds an item stack in slot.
+type Stack struct {
+	slot stream.Slot
+}
+
+func NewStack(id uint16, count uint8) *Stack {
+	return &Stack{slot: stream.Slot{ID: int16(id), Count: count}}
+}
+
+func (s *Stack) Damage(damage int16) *Stack {
+	s.slot.Damage = damage
+	return s
+}
+
+func (s *Stack) tag() nbt.Compound {
+	if s.slot.NBT == nil {
+		s.slot.NBT = nbt.Compound{}
+	}
+	return s.slot.NBT
+}
+
+func (s *Stack) display() nbt.Compound {
+	tag := s.tag()
+	display, ok := tag["display"].(nbt.Compound)
+	if !ok {
+		display = nbt.Compound{}
+		tag["display"] = display
+	}
+	return display
+}
+
+// DisplayName set the custom name of item.
+func (s *Stack) DisplayName(name string) *Stack {
+	s.display()["Name"] = nbt.String(name)
+	return s
+}
+
+// Lore append lines of description under the name.
+func (s *Stack) Lore(lines ...string) *Stack {
+	display := s.display()
+	var lore []nbt.String
+	if old, ok := display["Lore"].(nbt.List); ok {
+		lore, _ = old.GetStringList()
+	}
+	for _, line := range lines {
+		lore = append(lore, nbt.String(line))
+	}
+	display["Lore"] = nbt.MakeStringList(lore)
+	return s
+}
+
+// Enchant add enchantment of level to item.
+func (s *Stack) Enchant(enchantment Enchantment, level int16) *Stack {
+	tag := s.tag()
+	var enchantments []nbt.Compound
+	if old, ok := tag["ench"].(nbt.List); ok {
+		enchantments, _ = old.GetCompoundList()
+	}
+	enchantments = append(enchantments, nbt.Compound{
+		"id":  nbt.Short(enchantment),
+		"lvl": nbt.Short(level),
+	})
+	tag["ench"] = nbt.MakeCompoundList(enchantments)
+	return s
+}
+
+// Slot return the built item stack.
+func (s *Stack) Slot() stream.Slot {
+	return s.slot
+}
+
+// EnchantLevel return level of enchantment on item in slot, 0 if not enchanted.
+func EnchantLevel(slot stream.Slot, enchantment Enchantment) int {
+	list, ok := slot.NBT["ench"].(nbt.List)
+	if !ok {
+		return 0
+	}
+	enchantments, _ := list.GetCompoundList()
+	for _, e := range enchantments {
+		if id, _ := e["id"].(nbt.Short); Enchantment(id) == enchantment {
+			lvl, _ := e["lvl"].(nbt.Short)
+			return int(lvl)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,197 @@
+package item
+
+import (
+	"math"
+	"strings"
+
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+)
+
+// ToolKind is kind of tool which mines some blocks faster.
+type ToolKind uint8
+
+const (
+	ToolNone ToolKind = iota
+	ToolPickaxe
+	ToolShovel
+	ToolAxe
+	ToolSword
+	ToolShears
+	ToolHoe
+)
+
+// harvest levels of tool materials
+const (
+	LevelWood    = 0
+	LevelStone   = 1
+	LevelIron    = 2
+	LevelDiamond = 3
+)
+
+type toolMaterial struct {
+	Level int
+	Speed float64
+}
+
+var toolMaterials = map[string]toolMaterial{
+	"wooden":  {LevelWood, 2},
+	"stone":   {LevelStone, 4},
+	"iron":    {LevelIron, 6},
+	"diamond": {LevelDiamond, 8},
+	"golden":  {LevelWood, 12},
+}
+
+var toolKinds = map[string]ToolKind{
+	"pickaxe": ToolPickaxe,
+	"shovel":  ToolShovel,
+	"axe":     ToolAxe,
+	"sword":   ToolSword,
+	"hoe":     ToolHoe,
+}
+
+// Tool return kind, harvest level and mining speed of item, ToolNone if it is not a tool.
+func (it *Item) Tool() (ToolKind, int, float64) {
+	name := strings.TrimPrefix(it.Name, "minecraft:")
+	if name == "shears" {
+		return ToolShears, 0, 1
+	}
+	material, kind, ok := strings.Cut(name, "_")
+	if !ok {
+		return ToolNone, 0, 1
+	}
+	m, ok := toolMaterials[material]
+	if !ok || toolKinds[kind] == ToolNone {
+		return ToolNone, 0, 1
+	}
+	return toolKinds[kind], m.Level, m.Speed
+}
+
+// harvest is the tool mining a block faster,
+// Required means block drops nothing and mines slowly without tool of Level.
+type harvest struct {
+	Tool     ToolKind
+	Level    int
+	Required bool
+}
+
+var harvests = make(map[string]harvest)
+
+func setHarvest(h harvest, names ...string) {
+	for _, name := range names {
+		harvests["minecraft:"+name] = h
+	}
+}
+
+func init() {
+	setHarvest(harvest{ToolPickaxe, LevelWood, true},
+		"stone", "cobblestone", "mossy_cobblestone", "cobblestone_wall", "sandstone", "red_sandstone",
+		"coal_ore", "quartz_ore", "coal_block", "stone_slab", "double_stone_slab", "stone_slab2", "double_stone_slab2",
+		"brick_block", "stonebrick", "nether_brick", "red_nether_brick", "nether_brick_fence", "netherrack",
+		"stone_stairs", "brick_stairs", "stone_brick_stairs", "nether_brick_stairs", "sandstone_stairs",
+		"red_sandstone_stairs", "quartz_stairs", "quartz_block", "purpur_block", "purpur_pillar", "purpur_stairs",
+		"purpur_slab", "purpur_double_slab", "end_stone", "end_bricks", "prismarine", "magma", "bone_block",
+		"hardened_clay", "stained_hardened_clay", "concrete", "furnace", "lit_furnace", "dispenser", "dropper",
+		"observer", "mob_spawner", "enchanting_table", "ender_chest", "brewing_stand", "cauldron", "hopper",
+		"anvil", "iron_bars", "iron_door", "iron_trapdoor", "stone_pressure_plate", "light_weighted_pressure_plate",
+		"heavy_weighted_pressure_plate",
+		"white_glazed_terracotta", "orange_glazed_terracotta", "magenta_glazed_terracotta",
+		"light_blue_glazed_terracotta", "yellow_glazed_terracotta", "lime_glazed_terracotta",
+		"pink_glazed_terracotta", "gray_glazed_terracotta", "silver_glazed_terracotta", "cyan_glazed_terracotta",
+		"purple_glazed_terracotta", "blue_glazed_terracotta", "brown_glazed_terracotta",
+		"green_glazed_terracotta", "red_glazed_terracotta", "black_glazed_terracotta")
+	setHarvest(harvest{ToolPickaxe, LevelStone, true},
+		"iron_ore", "iron_block", "lapis_ore", "lapis_block")
+	setHarvest(harvest{ToolPickaxe, LevelIron, true},
+		"gold_ore", "gold_block", "diamond_ore", "diamond_block", "emerald_ore", "emerald_block",
+		"redstone_ore", "lit_redstone_ore")
+	setHarvest(harvest{ToolPickaxe, LevelDiamond, true}, "obsidian")
+	setHarvest(harvest{ToolPickaxe, LevelWood, false},
+		"ice", "packed_ice", "frosted_ice", "redstone_block", "stone_button", "rail", "golden_rail",
+		"detector_rail", "activator_rail", "glowstone", "sea_lantern", "glass", "stained_glass")
+
+	setHarvest(harvest{ToolShovel, LevelWood, false},
+		"dirt", "grass", "grass_path", "farmland", "mycelium", "sand", "gravel", "clay", "soul_sand", "concrete_powder")
+	setHarvest(harvest{ToolShovel, LevelWood, true}, "snow", "snow_layer")
+
+	setHarvest(harvest{ToolAxe, LevelWood, false},
+		"planks", "log", "log2", "wooden_slab", "double_wooden_slab", "bookshelf", "chest", "trapped_chest",
+		"crafting_table", "jukebox", "noteblock", "pumpkin", "lit_pumpkin", "melon_block", "ladder",
+		"wooden_button", "wooden_pressure_plate", "trapdoor", "standing_sign", "wall_sign", "standing_banner",
+		"wall_banner", "daylight_detector", "daylight_detector_inverted", "cocoa", "brown_mushroom_block",
+		"red_mushroom_block", "oak_stairs", "spruce_stairs", "birch_stairs", "jungle_stairs", "acacia_stairs",
+		"dark_oak_stairs", "fence", "spruce_fence", "birch_fence", "jungle_fence", "dark_oak_fence",
+		"acacia_fence", "fence_gate", "spruce_fence_gate", "birch_fence_gate", "jungle_fence_gate",
+		"dark_oak_fence_gate", "acacia_fence_gate", "wooden_door", "spruce_door", "birch_door", "jungle_door",
+		"acacia_door", "dark_oak_door")
+
+	setHarvest(harvest{ToolShears, LevelWood, false}, "leaves", "leaves2", "wool", "vine")
+	setHarvest(harvest{ToolSword, LevelWood, true}, "web")
+}
+
+// speedOn return how fast tool mines block, and whether it can harvest block.
+func speedOn(b *world.Block, it *Item) (float64, bool) {
+	h, ok := harvests[b.Name]
+	if it == nil {
+		return 1, !h.Required
+	}
+	kind, level, speed := it.Tool()
+
+	switch {
+	case b.Name == "minecraft:web" && (kind == ToolSword || kind == ToolShears):
+		return 15, true
+	case kind == ToolSword:
+		// swords cut plants a bit faster but are not mining tools
+		if b.Name == "minecraft:leaves" || b.Name == "minecraft:leaves2" || b.Name == "minecraft:vine" {
+			return 1.5, !h.Required
+		}
+		return 1, !h.Required
+	case kind == ToolShears:
+		if b.Name == "minecraft:wool" {
+			return 5, true
+		}
+		if ok && h.Tool == ToolShears {
+			return 15, true
+		}
+		return 1, !h.Required
+	case ok && kind != ToolNone && kind == h.Tool:
+		return speed, !h.Required || level >= h.Level
+	default:
+		return 1, !h.Required
+	}
+}
+
+// BreakTicks return ticks to break state in survival with held item,
+// 0 if it breaks instantly and -1 if it can never be broken.
+func BreakTicks(state world.BlockState, held stream.Slot) int {
+	b := state.Block()
+	if b == nil || b.Unbreakable() {
+		return -1
+	}
+	if b.Hardness == 0 {
+		return 0
+	}
+
+	var it *Item
+	if !held.Empty() {
+		it = ByID(uint16(held.ID))
+	}
+	speed, canHarvest := speedOn(b, it)
+	if speed > 1 {
+		if level := EnchantLevel(held, EnchantEfficiency); level > 0 {
+			speed += float64(level*level + 1)
+		}
+	}
+
+	// the same as damage added to block each tick in vanilla
+	damage := speed / float64(b.Hardness)
+	if canHarvest {
+		damage /= 30
+	} else {
+		damage /= 100
+	}
+	if damage >= 1 {
+		return 0
+	}
+	return int(math.Ceil(1 / damage))
+}
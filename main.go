@@ -1,18 +1,21 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
 	"net"
+	"os"
+	"os/signal"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,8 +23,7 @@ import (
 	"github.com/laushunyu/real/packet"
 	"github.com/laushunyu/real/stream"
 	"github.com/laushunyu/real/utils"
-	"github.com/laushunyu/real/world/generate"
-	"github.com/seebs/nbt"
+	"github.com/laushunyu/real/world"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -33,13 +35,103 @@ type server struct {
 	addr string
 	l    net.Listener
 
+	MaxPlayers int
+	// SavesDir is where vanilla worlds saved, each world in a directory of its name
+	SavesDir string
+	// Worlds hosted, players join the first one
+	Worlds []WorldConfig
+	// ViewDistance is the max radius in chunks sent to players
+	ViewDistance int32
+	// ChunksPerTick is how many chunks can be sent to a player in a tick
+	ChunksPerTick int
+	// AutoSaveInterval is how often worlds are saved, 0 to disable
+	AutoSaveInterval time.Duration
+	// default tab list header and footer
+	TabHeader, TabFooter Chat
+
+	mu      sync.RWMutex
 	players []*Player
+
+	worlds  *world.Manager
+	tracker *EntityTracker
+	// loop of each world, not changed after NewServer
+	tickers map[*world.World]*ticker
+}
+
+// Players return a snapshot of online players.
+func (s *server) Players() []*Player {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]*Player(nil), s.players...)
+}
+
+// Online report whether p joined and not quit yet.
+func (s *server) Online(p *Player) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, other := range s.players {
+		if other == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Broadcast send pkt to all online players.
+func (s *server) Broadcast(pkt packet.Packet) {
+	for _, p := range s.Players() {
+		p.Send(pkt)
+	}
+}
+
+// eachPlayer run fn for every online player, players in other worlds than t
+// are handed to their own loops.
+func (s *server) eachPlayer(t *ticker, fn func(p *Player)) {
+	for _, p := range s.Players() {
+		if p.World() == t.world {
+			fn(p)
+		} else {
+			p := p
+			p.Schedule(func() { fn(p) })
+		}
+	}
 }
 
+// JoinPlayer add p to the game, it is called in the loop of p's world.
 func (s *server) JoinPlayer(p *Player) {
+	p.setBuffered(true)
+	s.mu.Lock()
 	s.players = append(s.players, p)
-	for _, p := range s.players {
-		p.SendChat(Chat{
+	s.mu.Unlock()
+
+	t := s.loop(p.world)
+	t.addPlayer(p)
+	p.world.AddEntity(p)
+
+	// tell new player who is online, and others that new player comes,
+	// players in other worlds tell it themselves
+	s.eachPlayer(t, func(other *Player) {
+		p.Send(NewPlayerListItemPacket(constants.PlayerListAddPlayer, other))
+	})
+	join := NewPlayerListItemPacket(constants.PlayerListAddPlayer, p)
+	for _, other := range s.Players() {
+		if other != p {
+			other.Send(join)
+		}
+	}
+	// spawn players nearby after they are in list
+	s.tracker.Update(p, t.players)
+	p.SendInventory()
+	p.SendRecipes()
+	p.SendHealth()
+	p.Send(timePacket(p.world))
+	for _, pkt := range weatherPackets(p.world) {
+		p.Send(pkt)
+	}
+
+	s.eachPlayer(t, func(other *Player) { other.SendTabListHeaderFooter() })
+	for _, other := range s.Players() {
+		other.SendChat(Chat{
 			Text: "[刺溜]",
 			Bold: true,
 			Extra: []Chat{
@@ -64,8 +156,104 @@ func (s *server) JoinPlayer(p *Player) {
 	}
 }
 
+// QuitPlayer remove p from the game, it is called in the loop of p's world.
+func (s *server) QuitPlayer(p *Player) {
+	s.mu.Lock()
+	joined := false
+	for i, other := range s.players {
+		if other == p {
+			s.players = append(s.players[:i], s.players[i+1:]...)
+			joined = true
+			break
+		}
+	}
+	s.mu.Unlock()
+	if !joined {
+		return
+	}
+
+	t := s.loop(p.world)
+	t.removePlayer(p)
+	s.tracker.Remove(p)
+	p.world.RemoveEntity(p)
+	// items in window go back to inventory before saved
+	p.CloseWindow(false)
+	s.savePlayer(p)
+
+	s.Broadcast(NewPlayerListItemPacket(constants.PlayerListRemovePlayer, p))
+	s.eachPlayer(t, func(other *Player) { other.SendTabListHeaderFooter() })
+	p.setBuffered(false)
+}
+
 func NewServer(addr string) *server {
-	return &server{addr: addr}
+	s := &server{
+		addr:          addr,
+		MaxPlayers:    8,
+		ViewDistance:  8,
+		ChunksPerTick: 4,
+		TabHeader:     Chat{Text: "爷的 minecraft", Bold: true},
+		TabFooter:     Chat{Text: "在线 {online}/{max}  延迟 {ping}ms"},
+		SavesDir:      "saves",
+		Worlds:        DefaultWorlds,
+		worlds:        world.NewManager(),
+		tracker:       NewEntityTracker(48),
+		tickers:       make(map[*world.World]*ticker),
+
+		AutoSaveInterval: 5 * time.Minute,
+	}
+
+	for _, cfg := range s.Worlds {
+		if err := s.worlds.Add(s.loadWorld(cfg)); err != nil {
+			log.WithError(err).Errorf("failed to add world %s", cfg.Name)
+		}
+	}
+	for _, w := range s.worlds.All() {
+		s.tickers[w] = newTicker(w)
+	}
+	return s
+}
+
+// autoSave save worlds every AutoSaveInterval until listener closed.
+func (s *server) autoSave(done <-chan struct{}) {
+	if s.AutoSaveInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.AutoSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			// save in loop of each world so no block changes while saving
+			for _, t := range s.tickers {
+				t := t
+				t.schedule(func() {
+					for _, p := range t.players {
+						s.savePlayer(p)
+					}
+					if err := t.world.Save(); err != nil {
+						log.WithError(err).Errorf("failed to auto save world %s", t.world.Name)
+					}
+				})
+			}
+		}
+	}
+}
+
+// Shutdown stop accepting players, disconnect online ones and save worlds.
+func (s *server) Shutdown() error {
+	if s.l != nil {
+		s.l.Close()
+		s.StopTicks()
+	}
+	// ticks stopped, players won't quit in tick
+	s.savePlayers()
+	for _, p := range s.Players() {
+		p.Close()
+	}
+	log.Info("saving worlds")
+	return s.worlds.Close()
 }
 
 func (s *server) Run() error {
@@ -73,23 +261,31 @@ func (s *server) Run() error {
 	if err != nil {
 		return err
 	}
+	s.l = l
+
+	done := make(chan struct{})
+	defer close(done)
+	for _, t := range s.tickers {
+		go s.runTicks(t)
+	}
+	go s.autoSave(done)
 
 	for {
 		conn, err := l.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
 			log.Error(err)
 			continue
 		}
 
 		player := NewPlayer(conn)
-		// reset position and look
-		player.PL = PositionAndLook{
-			X:     0,
-			Y:     0,
-			Z:     0,
-			Yaw:   0,
-			Pitch: 0,
-		}
+		player.server = s
+		// spawn on top of the spawn point of the default world
+		player.world = s.worlds.Default()
+		player.PL = s.SpawnPosition(player.world)
+		player.setGameMode(byte(player.world.Level().GameType))
 
 		go func() {
 			defer func() {
@@ -98,6 +294,7 @@ func (s *server) Run() error {
 				}
 			}()
 			defer player.Close()
+			defer player.Schedule(func() { s.QuitPlayer(player) })
 
 			log.Infof("%s connected.", conn.RemoteAddr())
 
@@ -191,8 +388,8 @@ func (s *server) Run() error {
 								Online int                `json:"online"`
 								Sample []ServerInfoPlayer `json:"sample"`
 							}{
-								Max:    8,
-								Online: 1,
+								Max:    s.MaxPlayers,
+								Online: len(s.Players()),
 								Sample: []ServerInfoPlayer{
 									{Name: "macoo", Id: uuid.New().String()},
 								}},
@@ -234,7 +431,8 @@ func (s *server) Run() error {
 						player.Meta.User, _ = reader.ReadString()
 						log.Infof("%s login", player.Meta.User)
 
-						player.Meta.UserID = uuid.New()
+						player.Meta.UserID = OfflineUUID(player.Meta.User)
+						s.loadPlayer(player)
 
 						// send Login Success
 						// 0x02
@@ -248,52 +446,18 @@ func (s *server) Run() error {
 						// do send many data to client
 						// Event::LoginStart
 
-						// Join Game
-						joinGame := packet.NewPacket(0x23)
-						joinGame.
-							WriteInt(0).
-							WriteRaw([]byte{1}).
-							WriteInt(0).
-							WriteRaw([]byte{0, 20}).
-							WriteString("default").
-							WriteRaw([]byte{1})
-						player.Send(joinGame)
+						player.Send(NewJoinGamePacket(player, s.MaxPlayers))
 
-						// Player Abilities
-						playerAbilities := packet.NewPacket(0x2c)
-						playerAbilities.
-							WriteRaw([]byte{2 | 4 | 8}).
-							WriteFloat(float32(1) / 20). // Flying Speed
-							WriteFloat(0)                // 视角场
-						player.Send(playerAbilities)
+						player.Send(NewPlayerAbilitiesPacket(player.abilities))
 
-						// Player Position And Look
-						spawnEntity := packet.NewPacket(0x2f)
-						spawnEntity.
-							WriteDouble(player.PL.X).
-							WriteDouble(player.PL.Y).
-							WriteDouble(player.PL.Z).
-							WriteFloat(player.PL.Yaw).
-							WriteFloat(player.PL.Pitch).
-							WriteRaw([]byte{0xff}).
-							WriteVarInt(0)
-						player.Send(spawnEntity)
-
-						// player is in game, no others cover
-						// use event center to refactor
-						s.JoinPlayer(player)
-
-						// Chunk Data
-						// player.Send(PackChunk(int32(player.X)%16, int32(player.Z)%16, true))
-
-						// send spawn Chunk Data
-						centerX := int32(player.PL.X) / 16
-						centerZ := int32(player.PL.X) / 16
-						for x := -4; x < 4; x++ {
-							for z := -4; z < 4; z++ {
-								player.Send(generate.GetPlainChunkDataPacket(int32(x)+centerX, int32(z)+centerZ))
-							}
-						}
+						player.Send(NewPlayerPositionAndLookPacket(player.PL))
+
+						// player is in game from the next tick,
+						// spawn chunks are sent in chunk phase of ticks
+						player.Schedule(func() {
+							s.JoinPlayer(player)
+							player.UpdateChunks()
+						})
 
 						// do keep alive
 						keepAlive := packet.NewPacket(0x1F)
@@ -322,9 +486,17 @@ func (s *server) Run() error {
 					switch pkt.PacketID {
 					case 0x13:
 						// Player Abilities
-
+						// Sent when the player starts or stops flying, speeds are ignored.
+						flags, _ := reader.ReadByte()
+						player.Schedule(func() { player.HandleAbilities(flags) })
+						continue
 					case 0x0b:
 						// keep alive
+						// server send the unix nano as id, so we can get the ping
+						id, _ := reader.ReadLong()
+						ping := time.Since(time.Unix(0, int64(id))).Milliseconds()
+						player.Schedule(func() { player.SetPing(ping) })
+						continue
 					case 0x0d:
 						// Player Position
 						// Updates the player's XYZ position on the server.
@@ -332,7 +504,7 @@ func (s *server) Run() error {
 						y, _ := reader.ReadDouble()
 						z, _ := reader.ReadDouble()
 						onGround, _ := reader.ReadBoolean()
-						player.ChangePL(&Position{X: x, Y: y, Z: z}, nil, onGround)
+						player.Schedule(func() { player.ChangePL(&Position{X: x, Y: y, Z: z}, nil, onGround) })
 
 						continue
 					case 0x0e:
@@ -344,7 +516,7 @@ func (s *server) Run() error {
 						yaw, _ := reader.ReadFloat()
 						pitch, _ := reader.ReadFloat()
 						onGround, _ := reader.ReadBoolean()
-						player.ChangePL(&Position{X: x, Y: y, Z: z}, &Look{yaw, pitch}, onGround)
+						player.Schedule(func() { player.ChangePL(&Position{X: x, Y: y, Z: z}, &Look{yaw, pitch}, onGround) })
 						continue
 					case 0x0f:
 						// Player Look
@@ -352,7 +524,7 @@ func (s *server) Run() error {
 						yaw, _ := reader.ReadFloat()
 						pitch, _ := reader.ReadFloat()
 						onGround, _ := reader.ReadBoolean()
-						player.ChangePL(nil, &Look{yaw, pitch}, onGround)
+						player.Schedule(func() { player.ChangePL(nil, &Look{yaw, pitch}, onGround) })
 						continue
 					case 0x09:
 						// Plugin Message
@@ -361,68 +533,145 @@ func (s *server) Run() error {
 					case 0x04:
 						// Client Settings
 						// Sent when the chunkWrt connects, or when settings are changed.
+						_, _ = reader.ReadString() // locale
+						viewDistance, _ := reader.ReadByte()
+						_, _ = reader.ReadVarInt() // chat mode
+						_, _ = reader.ReadBoolean()
+						skinParts, _ := reader.ReadByte()
+						mainHand, _ := reader.ReadVarInt()
+						player.Schedule(func() {
+							player.meta.SetByte(world.MetaIndexSkinParts, skinParts)
+							player.meta.SetByte(world.MetaIndexMainHand, byte(mainHand))
+							player.FlushMetadata()
+							if int32(viewDistance) != player.ClientViewDistance {
+								player.ClientViewDistance = int32(viewDistance)
+								player.UpdateChunks()
+							}
+						})
 						continue
 					case 0x00:
 						// ack Player Position And Look
 						teleportID, _ := reader.ReadVarInt()
 						_ = teleportID
 
+						continue
+					case 0x07:
+						// Click Window
+						window, _ := reader.ReadByte()
+						slot, _ := reader.ReadShort()
+						button, _ := reader.ReadByte()
+						action, _ := reader.ReadShort()
+						mode, _ := reader.ReadVarInt()
+						clicked, _ := reader.ReadSlot()
+						player.Schedule(func() {
+							player.HandleClickWindow(window, int(int16(slot)), int(button), int16(action), int(mode), clicked)
+						})
+						continue
+					case 0x05:
+						// serverbound Confirm Transaction
+						// Sent back after a click is refused.
+						window, _ := reader.ReadByte()
+						_, _ = reader.ReadShort() // action number
+						accepted, _ := reader.ReadBoolean()
+						player.Schedule(func() { player.HandleConfirmTransaction(window, accepted) })
+						continue
+					case 0x12:
+						// Craft Recipe Request
+						// Sent when a recipe in recipe book is clicked.
+						window, _ := reader.ReadByte()
+						id, _ := reader.ReadVarInt()
+						makeAll, _ := reader.ReadBoolean()
+						player.Schedule(func() { player.HandleCraftRecipeRequest(window, int32(id), makeAll) })
+						continue
+					case 0x17:
+						// Crafting Book Data
+						typ, _ := reader.ReadVarInt()
+						var open, filtering bool
+						switch typ {
+						case CraftingBookDisplayed:
+							_, _ = reader.ReadInt() // recipe displayed
+						case CraftingBookStatus:
+							open, _ = reader.ReadBoolean()
+							filtering, _ = reader.ReadBoolean()
+						}
+						player.Schedule(func() { player.HandleCraftingBookData(int(typ), open, filtering) })
 						continue
 					case 0x08:
 						// client Close Window
+						window, _ := reader.ReadByte()
+						player.Schedule(func() { player.HandleCloseWindow(window) })
 						continue
-					case 0x02:
-						// ChatMessage
-						// The client sends the raw input.
-						input, _ := reader.ReadString()
-
-						if len(input) == 0 {
-							continue
+					case 0x1d:
+						// Animation
+						// Sent when the player's arm swings.
+						hand, _ := reader.ReadVarInt()
+						animation := byte(AnimationSwingMainArm)
+						if hand == 1 {
+							animation = AnimationSwingOffhand
 						}
-
-						if input[0] == '/' {
-							// command
-							log.WithField("player", player.Meta.User).Infof("input command: %s", input)
-							command := strings.Split(input[1:], " ")
-							if len(command) > 0 {
-								switch command[0] {
-								case "new":
-									switch command[1] {
-									case "player":
-										// generate new player in player's position
-										log.WithField("player", player.Meta.User).Infof("generating fake player")
-										// Spawn Player
-										spawnPlayerUUID := uuid.New()
-										spawnPlayer := packet.NewPacket(0x05)
-										spawnPlayer.WriteVarInt(123).
-											WriteRaw(spawnPlayerUUID[:]).
-											WriteDouble(player.PL.X).
-											WriteDouble(player.PL.Y).
-											WriteDouble(player.PL.Z).
-											WriteRaw([]byte{byte(player.PL.Yaw)}).
-											WriteRaw([]byte{byte(player.PL.Pitch)}).
-											WriteNbt(nbt.Compound{})
-										player.Send(spawnPlayer)
-
-									}
-								}
+						player.Schedule(func() { s.tracker.SendToWatchers(player, NewAnimationPacket(player.ID(), animation)) })
+						continue
+					case 0x15:
+						// Entity Action
+						// Sent by the client to indicate that it has performed certain actions.
+						_, _ = reader.ReadVarInt() // entity id, always be player itself
+						action, _ := reader.ReadVarInt()
+						player.Schedule(func() {
+							switch action {
+							case 0:
+								world.SetEntityFlag(player.meta, world.EntityFlagCrouched, true)
+							case 1:
+								world.SetEntityFlag(player.meta, world.EntityFlagCrouched, false)
+							case 3:
+								world.SetEntityFlag(player.meta, world.EntityFlagSprinting, true)
+							case 4:
+								world.SetEntityFlag(player.meta, world.EntityFlagSprinting, false)
 							}
+							player.FlushMetadata()
+						})
+						continue
+					case 0x14:
+						// Player Digging
+						status, _ := reader.ReadVarInt()
+						position, _ := reader.ReadPosition()
+						_, _ = reader.ReadByte() // face
+						player.Schedule(func() { player.HandleDigging(int(status), position) })
+						continue
+					case 0x1f:
+						// Player Block Placement
+						position, _ := reader.ReadPosition()
+						face, _ := reader.ReadVarInt()
+						hand, _ := reader.ReadVarInt()
+						_, _ = reader.ReadFloat() // cursor x
+						cursorY, _ := reader.ReadFloat()
+						_, _ = reader.ReadFloat() // cursor z
+						player.Schedule(func() { player.HandlePlacement(position, int(face), int(hand), cursorY) })
+						continue
+					case 0x1a:
+						// Held Item Change
+						slot, _ := reader.ReadShort()
+						player.Schedule(func() { player.HandleHeldItemChange(int(int16(slot))) })
+						continue
+					case 0x03:
+						// Client Status
+						// Sent when the player clicks respawn on death screen.
+						action, _ := reader.ReadVarInt()
+						player.Schedule(func() { player.HandleClientStatus(int(action)) })
+						continue
+					case 0x1b:
+						// Creative Inventory Action
+						slot, _ := reader.ReadShort()
+						item, err := reader.ReadSlot()
+						if err != nil {
 							continue
 						}
-
-						chatMessage := packet.NewPacket(0x0F)
-						msg := Chat{
-							Text: fmt.Sprintf("[%s]", player.Meta.User),
-							Bold: true,
-							Extra: []Chat{
-								{
-									Text: input,
-									Bold: false,
-								},
-							},
-						}
-						chatMessage.WriteString(msg.String()).WriteRaw([]byte{0})
-						player.Send(chatMessage)
+						player.Schedule(func() { player.HandleCreativeInventoryAction(int(int16(slot)), item) })
+						continue
+					case 0x02:
+						// ChatMessage
+						// The client sends the raw input.
+						input, _ := reader.ReadString()
+						player.Schedule(func() { player.HandleChat(input) })
 						continue
 					}
 				}
@@ -456,6 +705,7 @@ type ServerInfoPlayer struct {
 type Chat struct {
 	Text  string `json:"text"`
 	Bold  bool   `json:"bold"`
+	Color string `json:"color,omitempty"`
 	Extra []Chat `json:"extra,omitempty"`
 }
 
@@ -464,6 +714,19 @@ func (c Chat) String() string {
 	return string(raw)
 }
 
+// Replace return a copy of chat with text of itself and extra replaced by r.
+func (c Chat) Replace(r *strings.Replacer) Chat {
+	c.Text = r.Replace(c.Text)
+	if c.Extra != nil {
+		extra := make([]Chat, len(c.Extra))
+		for i := range c.Extra {
+			extra[i] = c.Extra[i].Replace(r)
+		}
+		c.Extra = extra
+	}
+	return c
+}
+
 type PositionAndLook struct {
 	X, Y, Z    float64
 	Yaw, Pitch float32
@@ -479,38 +742,78 @@ type Look struct {
 }
 
 type Player struct {
-	PL        PositionAndLook
+	entityID int32
+	PL       PositionAndLook
+	// Vel is the last movement in blocks per tick
+	Vel       world.Vec3
 	ConnState constants.ConnState
 	Meta      PlayerMeta
 
+	GameMode byte
+	// abilities sent to client, decided by GameMode
+	abilities Abilities
+	Ping      int64 // in milliseconds
+	// ClientViewDistance from Client Settings
+	ClientViewDistance int32
+	// DisplayName in tab list, nil to use Meta.User
+	DisplayName *Chat
+	// TabHeader and TabFooter of tab list, nil to use server's
+	TabHeader, TabFooter *Chat
+
+	meta   *stream.Metadata
+	chunks chunkView
+	// inventory is changed only in tick
+	inventory *Inventory
+	// window is the container opened, nil if none
+	window    *Window
+	invWindow *Window
+	windowID  byte
+	// recipeBook is state of recipe book told by client
+	recipeBook RecipeBook
+	vitals     Vitals
+	hurting    hurting
+	digging    digging
+	server     *server
+	// world is changed in loops under worldMu, only its loop reads it without lock
+	worldMu sync.RWMutex
+	world   *world.World
+
 	closeOnce sync.Once
 	conn      net.Conn
-	sendCh    chan packet.Packet
-	doneCh    chan struct{}
+	// batches of packets to be written
+	sendCh chan []packet.Packet
+	doneCh chan struct{}
+
+	// packets sent in tick wait here for outgoing phase once player joined
+	outMu    sync.Mutex
+	outbox   []packet.Packet
+	buffered bool
 }
 
 type PlayerMeta struct {
 	RemoteAddr string
 	User       string
 	UserID     uuid.UUID
+	Properties []PlayerProperty // skin and cape
 }
 
 func (player *Player) ChangePL(position *Position, look *Look, onGround bool) {
 	if position != nil {
-		oldChunkX, oldChunkZ := int64(player.PL.X)/16, int64(player.PL.Z)/16
-		newChunkX, newChunkZ := int64(position.X)/16, int64(position.Z)/16
+		oldChunk := ChunkPosOf(player.PL.X, player.PL.Z)
+		newChunk := ChunkPosOf(position.X, position.Z)
 
+		player.Vel = world.Vec3{X: position.X - player.PL.X, Y: position.Y - player.PL.Y, Z: position.Z - player.PL.Z}
 		player.PL.X = position.X
 		player.PL.Y = position.Y
 		player.PL.Z = position.Z
 
-		if oldChunkX != newChunkX || oldChunkZ != newChunkZ {
+		if oldChunk != newChunk {
 			ec.Send(EventChunkChange{
 				player: player,
-				SrcX:   oldChunkX,
-				SrcZ:   oldChunkZ,
-				DstX:   newChunkX,
-				DstZ:   newChunkZ,
+				SrcX:   int64(oldChunk.X),
+				SrcZ:   int64(oldChunk.Z),
+				DstX:   int64(newChunk.X),
+				DstZ:   int64(newChunk.Z),
 			})
 		}
 	}
@@ -520,7 +823,15 @@ func (player *Player) ChangePL(position *Position, look *Look, onGround bool) {
 		player.PL.Pitch = look.Pitch
 	}
 
+	wasOnGround := player.PL.OnGround
 	player.PL.OnGround = onGround
+	var delta world.Vec3
+	if position != nil {
+		delta = player.Vel
+	}
+	player.move(delta, wasOnGround)
+
+	player.server.tracker.Update(player, player.server.loop(player.world).players)
 }
 
 func (player *Player) SendChat(msg Chat) {
@@ -529,10 +840,6 @@ func (player *Player) SendChat(msg Chat) {
 	player.Send(chatMessage)
 }
 
-func (player *Player) Send(pkt packet.Packet) {
-	player.sendCh <- pkt
-}
-
 func (player *Player) Done() chan struct{} {
 	return player.doneCh
 }
@@ -546,24 +853,41 @@ func (player *Player) Close() (err error) {
 }
 
 func NewPlayer(conn net.Conn) *Player {
+	inv := NewInventory()
 	player := &Player{
-		conn: conn,
+		entityID:  world.NextEntityID(),
+		meta:      world.NewPlayerMetadata(),
+		chunks:    chunkView{loaded: make(map[world.ChunkPos]struct{})},
+		inventory: inv,
+		invWindow: newInventoryWindow(inv),
+		conn:      conn,
 		Meta: PlayerMeta{
 			RemoteAddr: conn.RemoteAddr().String(),
 			User:       "",         // this should get from db
 			UserID:     uuid.New(), // this should get from db
 		},
 		ConnState: constants.ConnStateInit,
-		sendCh:    make(chan packet.Packet, 8),
+		GameMode:  constants.GameModeCreative,
+		abilities: AbilitiesOf(constants.GameModeCreative),
+		vitals:    DefaultVitals(),
+		hurting:   hurting{air: MaxAir},
+		sendCh:    make(chan []packet.Packet, 8),
 		doneCh:    make(chan struct{}),
 	}
 	go func() {
+		w := bufio.NewWriter(conn)
 	loop:
 		for {
 			select {
-			case pkt := <-player.sendCh:
-				// calculate pkt length and write back
-				if _, err := pkt.WriteTo(conn); err != nil {
+			case pkts := <-player.sendCh:
+				// calculate pkt length and write back, a batch in one write
+				for _, pkt := range pkts {
+					if _, err := pkt.WriteTo(w); err != nil {
+						log.Error(err)
+						break loop
+					}
+				}
+				if err := w.Flush(); err != nil {
 					log.Error(err)
 					break loop
 				}
@@ -583,5 +907,22 @@ func NewPlayer(conn net.Conn) *Player {
 
 func main() {
 	srv := NewServer(":65535")
-	log.Error(srv.Run())
+
+	// save world before exit
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	shutdown := make(chan error, 1)
+	go func() {
+		<-sig
+		shutdown <- srv.Shutdown()
+	}()
+
+	if err := srv.Run(); err != nil {
+		log.Error(err)
+		return
+	}
+	// Run returns nil only after listener closed by Shutdown
+	if err := <-shutdown; err != nil {
+		log.WithError(err).Error("failed to save worlds")
+	}
 }
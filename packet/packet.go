@@ -37,7 +37,9 @@ func (pkt Packet) WriteTo(w io.Writer) (n int64, err error) {
 	if err := stream.NewWriter(w).WriteVarInt(pkt.Size()).WriteVarInt(pkt.id).Error; err != nil {
 		return 0, err
 	}
-	return pkt.buf.WriteTo(w)
+	// write without draining buf, so a packet can be sent to many players
+	n2, err := w.Write(pkt.buf.Bytes())
+	return int64(n2), err
 }
 
 func NewPacket(id uint64) Packet {
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/laushunyu/real/constants"
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+)
+
+// faces of a block in Player Digging and Player Block Placement
+const (
+	FaceBottom = 0
+	FaceTop    = 1
+	FaceNorth  = 2
+	FaceSouth  = 3
+	FaceWest   = 4
+	FaceEast   = 5
+)
+
+var (
+	faceOffsets = [6][3]int32{{0, -1, 0}, {0, 1, 0}, {0, 0, -1}, {0, 0, 1}, {-1, 0, 0}, {1, 0, 0}}
+	// facing property of each face
+	faceNames = [6]string{"down", "up", "north", "south", "west", "east"}
+	faceAxes  = [6]string{"y", "y", "z", "z", "x", "x"}
+
+	opposite = map[string]string{
+		"down": "up", "up": "down",
+		"north": "south", "south": "north",
+		"west": "east", "east": "west",
+	}
+)
+
+// blocks which can be placed into directly, instead of next to them
+var replaceable = map[string]bool{
+	"minecraft:air":           true,
+	"minecraft:water":         true,
+	"minecraft:flowing_water": true,
+	"minecraft:lava":          true,
+	"minecraft:flowing_lava":  true,
+	"minecraft:tallgrass":     true,
+	"minecraft:deadbush":      true,
+	"minecraft:vine":          true,
+	"minecraft:fire":          true,
+	"minecraft:snow_layer":    true,
+}
+
+func isReplaceable(state world.BlockState) bool {
+	b := state.Block()
+	return b == nil || replaceable[b.Name]
+}
+
+// blocks attached to the face they are placed against
+var attached = map[string]bool{
+	"minecraft:torch":                true,
+	"minecraft:redstone_torch":       true,
+	"minecraft:unlit_redstone_torch": true,
+	"minecraft:ladder":               true,
+	"minecraft:wall_sign":            true,
+	"minecraft:wall_banner":          true,
+	"minecraft:stone_button":         true,
+	"minecraft:wooden_button":        true,
+	"minecraft:tripwire_hook":        true,
+	"minecraft:end_rod":              true,
+	"minecraft:skull":                true,
+	"minecraft:trapdoor":             true,
+	"minecraft:iron_trapdoor":        true,
+}
+
+func offset(pos stream.Position, face int) stream.Position {
+	d := faceOffsets[face]
+	return stream.Position{X: pos.X + d[0], Y: pos.Y + d[1], Z: pos.Z + d[2]}
+}
+
+// horizontalFacing return direction of yaw, yaw 0 looks to south.
+func horizontalFacing(yaw float32) string {
+	return [4]string{"south", "west", "north", "east"}[int(math.Floor(float64(yaw)/90+0.5))&3]
+}
+
+// lookFacing return direction of look, up or down if pitch is steep.
+func lookFacing(look PositionAndLook) string {
+	switch {
+	case look.Pitch > 45:
+		return "down"
+	case look.Pitch < -45:
+		return "up"
+	default:
+		return horizontalFacing(look.Yaw)
+	}
+}
+
+// orient set properties of state which depend on clicked face,
+// cursor position on the face and where player is looking.
+func orient(state world.BlockState, face int, cursorY float32, look PositionAndLook) world.BlockState {
+	b := state.Block()
+	values := make(map[string]map[string]bool)
+	for _, v := range b.Variants {
+		for _, p := range v.Properties {
+			if values[p.Name] == nil {
+				values[p.Name] = make(map[string]bool)
+			}
+			values[p.Name][p.Value] = true
+		}
+	}
+	props := make(map[string]string)
+	for _, p := range state.Properties() {
+		props[p.Name] = p.Value
+	}
+	facing := horizontalFacing(look.Yaw)
+	name := strings.TrimPrefix(b.Name, "minecraft:")
+
+	if values["axis"]["y"] {
+		props["axis"] = faceAxes[face]
+	}
+	if values["rotation"] != nil {
+		props["rotation"] = strconv.Itoa(int(math.Floor(float64(look.Yaw+180)*16/360+0.5)) & 15)
+	}
+
+	if v := values["facing"]; v != nil {
+		switch {
+		case name == "lever":
+			// levers on floor and ceiling also keep the axis player looks along
+			axis := "z"
+			if facing == "east" || facing == "west" {
+				axis = "x"
+			}
+			switch face {
+			case FaceTop:
+				props["facing"] = "up_" + axis
+			case FaceBottom:
+				props["facing"] = "down_" + axis
+			default:
+				props["facing"] = faceNames[face]
+			}
+		case name == "hopper":
+			// hoppers point into the clicked block
+			props["facing"] = opposite[faceNames[face]]
+			if face == FaceBottom {
+				props["facing"] = "down"
+			}
+		case attached[b.Name] || strings.HasSuffix(name, "shulker_box"):
+			if v[faceNames[face]] {
+				props["facing"] = faceNames[face]
+			} else {
+				props["facing"] = opposite[facing]
+			}
+		case name == "observer":
+			// observers look the same way as player
+			props["facing"] = lookFacing(look)
+		case v["up"]:
+			// pistons and dispensers push towards player
+			props["facing"] = opposite[lookFacing(look)]
+		case strings.HasSuffix(name, "_stairs") || strings.HasSuffix(name, "door") ||
+			strings.HasSuffix(name, "fence_gate") || name == "bed":
+			props["facing"] = facing
+		default:
+			// front of furnaces, chests and pumpkins face player
+			props["facing"] = opposite[facing]
+		}
+	}
+
+	if v := values["half"]; v["top"] {
+		if face == FaceBottom || face != FaceTop && cursorY > 0.5 {
+			props["half"] = "top"
+		} else {
+			props["half"] = "bottom"
+		}
+	} else if v["lower"] {
+		props["half"] = "lower"
+	}
+
+	if oriented, ok := b.State(props); ok {
+		return oriented
+	}
+	return state
+}
+
+// upperHalf return the upper half of doors and tall plants placed as lower.
+func upperHalf(state world.BlockState) (world.BlockState, bool) {
+	if property(state, "half") != "lower" {
+		return world.Air, false
+	}
+	return state.Block().State(map[string]string{"half": "upper"})
+}
+
+// HandlePlacement handle Player Block Placement of item in hand against face of block at pos.
+func (player *Player) HandlePlacement(pos stream.Position, face int, hand int, cursorY float32) {
+	if face < 0 || face >= len(faceOffsets) || !player.canReach(pos) {
+		return
+	}
+	if hand == 0 && player.interact(pos) {
+		return
+	}
+	if !player.CanBuild() {
+		player.ResendBlock(offset(pos, face))
+		return
+	}
+	held := player.HeldItem(hand)
+	if held.Empty() {
+		return
+	}
+	it := item.ByID(uint16(held.ID))
+	if it == nil {
+		return
+	}
+	state, ok := it.BlockState(held.Damage)
+	if !ok {
+		return
+	}
+
+	w := player.world
+	target := pos
+	if !isReplaceable(w.Block(int(pos.X), int(pos.Y), int(pos.Z))) {
+		target = offset(pos, face)
+	}
+	refuse := func() {
+		player.ResendBlock(pos)
+		player.ResendBlock(target)
+	}
+	if !player.canReach(target) ||
+		!isReplaceable(w.Block(int(target.X), int(target.Y), int(target.Z))) {
+		refuse()
+		return
+	}
+
+	state = orient(state, face, cursorY, player.PL)
+	changes := []BlockChange{{Pos: target, State: state}}
+	if upper, ok := upperHalf(state); ok {
+		above := stream.Position{X: target.X, Y: target.Y + 1, Z: target.Z}
+		if above.Y >= world.SectionCount*16 || !isReplaceable(w.Block(int(above.X), int(above.Y), int(above.Z))) {
+			refuse()
+			return
+		}
+		changes = append(changes, BlockChange{Pos: above, State: upper})
+	}
+
+	// solid blocks must not be placed inside anyone
+	if state.Block().Solid {
+		box := world.AABB{
+			Min: world.Vec3{X: float64(target.X), Y: float64(target.Y), Z: float64(target.Z)},
+			Max: world.Vec3{X: float64(target.X + 1), Y: float64(target.Y + 1), Z: float64(target.Z + 1)},
+		}
+		for _, e := range w.Entities() {
+			if e.BoundingBox().Intersects(box) {
+				refuse()
+				return
+			}
+		}
+	}
+
+	player.server.SetBlocks(w, changes...)
+	if player.GameMode != constants.GameModeCreative {
+		player.ConsumeHeldItem(hand)
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"crypto/md5"
+
+	"github.com/google/uuid"
+	"github.com/laushunyu/real/world"
+	log "github.com/sirupsen/logrus"
+)
+
+// OfflineUUID return uuid of player in offline mode like vanilla,
+// which is version 3 uuid of "OfflinePlayer:<name>".
+func OfflineUUID(name string) uuid.UUID {
+	id := uuid.UUID(md5.Sum([]byte("OfflinePlayer:" + name)))
+	id[6] = id[6]&0x0f | 0x30
+	id[8] = id[8]&0x3f | 0x80
+	return id
+}
+
+// playerWorld return world saved in data, it falls back to a world of the same dimension.
+func (s *server) playerWorld(data *world.PlayerData) *world.World {
+	if w := s.worlds.Get(data.World); w != nil {
+		return w
+	}
+	for _, w := range s.worlds.All() {
+		if w.Dimension == data.Dimension {
+			return w
+		}
+	}
+	return nil
+}
+
+// loadPlayer restore player saved in the default world before it joins,
+// new players are kept at spawn.
+func (s *server) loadPlayer(p *Player) {
+	data, err := s.worlds.Default().LoadPlayer(p.UUID())
+	if err != nil {
+		log.WithError(err).Errorf("failed to load player %s", p.Meta.User)
+		return
+	}
+	if data == nil {
+		return
+	}
+
+	if w := s.playerWorld(data); w != nil {
+		p.world = w
+		p.PL = PositionAndLook{X: data.X, Y: data.Y, Z: data.Z, Yaw: data.Yaw, Pitch: data.Pitch, OnGround: data.OnGround}
+	}
+	p.setGameMode(byte(data.GameMode))
+	p.inventory.SetData(data.Inventory)
+	if data.SelectedSlot >= 0 && data.SelectedSlot < 9 {
+		p.inventory.Held = int(data.SelectedSlot)
+	}
+	p.recipeBook = RecipeBook{Open: data.RecipeBookOpen, Filtering: data.RecipeBookFiltering}
+	p.vitals = Vitals{Health: data.Health, Food: data.Food, Saturation: data.Saturation, Exhaustion: data.Exhaustion}
+	p.hurting = hurting{air: int(data.Air), fireTicks: int(data.Fire), fallDistance: float64(data.FallDistance)}
+	if p.vitals.Health <= 0 {
+		// player quit on death screen respawns at once
+		p.vitals = DefaultVitals()
+		p.hurting = hurting{air: MaxAir}
+		p.world = s.worlds.Default()
+		p.PL = s.SpawnPosition(p.world)
+	}
+}
+
+// savePlayer save player into the default world.
+func (s *server) savePlayer(p *Player) {
+	data := &world.PlayerData{
+		UUID:         p.UUID(),
+		World:        p.world.Name,
+		Dimension:    p.world.Dimension,
+		X:            p.PL.X,
+		Y:            p.PL.Y,
+		Z:            p.PL.Z,
+		Yaw:          p.PL.Yaw,
+		Pitch:        p.PL.Pitch,
+		OnGround:     p.PL.OnGround,
+		GameMode:     int32(p.GameMode),
+		SelectedSlot: int32(p.inventory.Held),
+		Inventory:    p.inventory.Data(),
+
+		RecipeBookOpen:      p.recipeBook.Open,
+		RecipeBookFiltering: p.recipeBook.Filtering,
+
+		Health:       p.vitals.Health,
+		Food:         p.vitals.Food,
+		Saturation:   p.vitals.Saturation,
+		Exhaustion:   p.vitals.Exhaustion,
+		Air:          int16(p.hurting.air),
+		Fire:         int16(p.hurting.fireTicks),
+		FallDistance: float32(p.hurting.fallDistance),
+	}
+	if err := s.worlds.Default().SavePlayer(data); err != nil {
+		log.WithError(err).Errorf("failed to save player %s", p.Meta.User)
+	}
+}
+
+// savePlayers save all online players.
+func (s *server) savePlayers() {
+	for _, p := range s.Players() {
+		s.savePlayer(p)
+	}
+}
@@ -0,0 +1,205 @@
+//go:build ignore
+
+// gen_recipes generate recipes_gen.go from recipes.json, which is in the format of 1.12 recipe files
+// named by name.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+// stack is an item of ingredient or result, data 32767 matches any damage.
+type stack struct {
+	Item  string `json:"item"`
+	Data  int16  `json:"data"`
+	Count int    `json:"count"`
+}
+
+// choices is an ingredient, a single item or an array of items.
+type choices []stack
+
+func (c *choices) UnmarshalJSON(raw []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		return json.Unmarshal(raw, (*[]stack)(c))
+	}
+	var s stack
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return err
+	}
+	*c = choices{s}
+	return nil
+}
+
+type crafting struct {
+	Name        string             `json:"name"`
+	Type        string             `json:"type"`
+	Group       string             `json:"group"`
+	Pattern     []string           `json:"pattern"`
+	Key         map[string]choices `json:"key"`
+	Ingredients []choices          `json:"ingredients"`
+	Result      stack              `json:"result"`
+}
+
+type smelting struct {
+	Input      stack   `json:"input"`
+	Result     stack   `json:"result"`
+	Experience float32 `json:"experience"`
+}
+
+type fuel struct {
+	Item  string `json:"item"`
+	Ticks int    `json:"ticks"`
+}
+
+// firstID is id of the first recipe of files, ids before are special recipes
+// like armor dyeing registered by code of client.
+const firstID = 11
+
+type item struct {
+	Name string `json:"name"`
+}
+
+// camel convert minecraft:flowing_water to FlowingWater.
+func camel(name string) string {
+	name = strings.TrimPrefix(name, "minecraft:")
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func load(file string, v interface{}) {
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	var recipes struct {
+		Crafting []crafting `json:"crafting"`
+		Smelting []smelting `json:"smelting"`
+		Fuels    []fuel     `json:"fuels"`
+	}
+	load("recipes.json", &recipes)
+	var items []item
+	load("../item/items.json", &items)
+	known := make(map[string]bool)
+	for _, it := range items {
+		known[it.Name] = true
+	}
+
+	itemConst := func(name string) string {
+		if !known[name] {
+			log.Fatalf("unknown item %s", name)
+		}
+		return "item." + camel(name)
+	}
+	ingredient := func(c choices) string {
+		if len(c) == 0 {
+			return "nil"
+		}
+		parts := make([]string, len(c))
+		for i, s := range c {
+			parts[i] = fmt.Sprintf("{ID: %s, Damage: %d}", itemConst(s.Item), s.Data)
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	result := func(s stack) string {
+		if s.Count == 0 {
+			s.Count = 1
+		}
+		return fmt.Sprintf("stream.Slot{ID: int16(%s), Count: %d, Damage: %d}", itemConst(s.Item), s.Count, s.Data)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintln(buf, `// Code generated by "go run gen_recipes.go"; DO NOT EDIT.`)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package recipe")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, `import (
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/stream"
+)`)
+	fmt.Fprintln(buf)
+
+	// client gives recipes ids in the order it finds the files in its jar, which is
+	// the reverse of their names, after the special recipes which are not files
+	crafting := recipes.Crafting
+	sort.Slice(crafting, func(i, j int) bool { return crafting[i].Name > crafting[j].Name })
+	for i := 1; i < len(crafting); i++ {
+		if crafting[i].Name == crafting[i-1].Name {
+			log.Fatalf("duplicate recipe %s", crafting[i].Name)
+		}
+	}
+
+	fmt.Fprintln(buf, "// firstID is id of the first recipe in crafting")
+	fmt.Fprintf(buf, "const firstID = %d\n\n", firstID)
+	fmt.Fprintln(buf, "var crafting = []Recipe{")
+	for i, r := range crafting {
+		id := firstID + i
+		var cells []string
+		switch r.Type {
+		case "crafting_shaped":
+			fmt.Fprintf(buf, "{ID: %d, Name: %q, Group: %q, Width: %d, Height: %d, Ingredients: []Ingredient{\n",
+				id, r.Name, r.Group, len(r.Pattern[0]), len(r.Pattern))
+			for _, row := range r.Pattern {
+				if len(row) != len(r.Pattern[0]) {
+					log.Fatalf("rows of %s are not of the same width", r.Name)
+				}
+				for _, k := range row {
+					c, ok := r.Key[string(k)]
+					if !ok && k != ' ' {
+						log.Fatalf("unknown key %c of %s", k, r.Name)
+					}
+					cells = append(cells, ingredient(c))
+				}
+			}
+		case "crafting_shapeless":
+			fmt.Fprintf(buf, "{ID: %d, Name: %q, Group: %q, Shapeless: true, Ingredients: []Ingredient{\n", id, r.Name, r.Group)
+			for _, c := range r.Ingredients {
+				cells = append(cells, ingredient(c))
+			}
+		default:
+			log.Fatalf("unknown type %s of %s", r.Type, r.Name)
+		}
+		fmt.Fprintln(buf, strings.Join(cells, ", ")+",")
+		fmt.Fprintf(buf, "}, Result: %s},\n", result(r.Result))
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+
+	fmt.Fprintln(buf, "var smelting = []Smelting{")
+	for _, s := range recipes.Smelting {
+		fmt.Fprintf(buf, "{Input: Choice{ID: %s, Damage: %d}, Result: %s, Experience: %g},\n",
+			itemConst(s.Input.Item), s.Input.Data, result(s.Result), s.Experience)
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+
+	fmt.Fprintln(buf, "// burn ticks of fuels")
+	fmt.Fprintln(buf, "var fuels = map[uint16]int{")
+	for _, f := range recipes.Fuels {
+		fmt.Fprintf(buf, "%s: %d,\n", itemConst(f.Item), f.Ticks)
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("recipes_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
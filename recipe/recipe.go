@@ -0,0 +1,190 @@
+package recipe
+
+//go:generate go run gen_recipes.go
+
+import (
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/stream"
+)
+
+// AnyDamage in a choice matches items of any damage, like planks of all woods.
+const AnyDamage = 32767
+
+// Choice is an item accepted by an ingredient.
+type Choice struct {
+	ID     uint16
+	Damage int16
+}
+
+// Ingredient is the items accepted in a cell of crafting grid, empty if the cell must be empty.
+type Ingredient []Choice
+
+// Match report whether it is accepted by ingredient.
+func (ing Ingredient) Match(it stream.Slot) bool {
+	if len(ing) == 0 {
+		return it.Empty()
+	}
+	if it.Empty() {
+		return false
+	}
+	for _, c := range ing {
+		if uint16(it.ID) == c.ID && (c.Damage == AnyDamage || c.Damage == it.Damage) {
+			return true
+		}
+	}
+	return false
+}
+
+// Recipe is a crafting recipe, its ID is the one client registered it by,
+// which recipe book packets use.
+type Recipe struct {
+	ID    int32
+	Name  string
+	Group string
+	// Shapeless recipes accept ingredients in any cells
+	Shapeless bool
+	// Width and Height of shaped recipes, Ingredients are the cells row by row
+	Width, Height int
+	Ingredients   []Ingredient
+	Result        stream.Slot
+}
+
+// Match report whether items in a size x size grid craft r.
+func (r *Recipe) Match(grid []stream.Slot, size int) bool {
+	if r.Shapeless {
+		return r.matchShapeless(grid)
+	}
+	return r.matchShaped(grid, size, false) || r.matchShaped(grid, size, true)
+}
+
+// matchShaped match pattern against the smallest box of items in grid, mirrored or not.
+func (r *Recipe) matchShaped(grid []stream.Slot, size int, mirror bool) bool {
+	minX, minY, maxX, maxY := size, size, -1, -1
+	for i, it := range grid {
+		if it.Empty() {
+			continue
+		}
+		x, y := i%size, i/size
+		if x < minX {
+			minX = x
+		}
+		if x > maxX {
+			maxX = x
+		}
+		if y < minY {
+			minY = y
+		}
+		if y > maxY {
+			maxY = y
+		}
+	}
+	if maxX-minX+1 != r.Width || maxY-minY+1 != r.Height {
+		return false
+	}
+	for y := 0; y < r.Height; y++ {
+		for x := 0; x < r.Width; x++ {
+			px := x
+			if mirror {
+				px = r.Width - 1 - x
+			}
+			if !r.Ingredients[y*r.Width+px].Match(grid[(minY+y)*size+minX+x]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchShapeless try to give each item in grid an ingredient of its own.
+func (r *Recipe) matchShapeless(grid []stream.Slot) bool {
+	var items []stream.Slot
+	for _, it := range grid {
+		if !it.Empty() {
+			items = append(items, it)
+		}
+	}
+	if len(items) != len(r.Ingredients) {
+		return false
+	}
+	used := make([]bool, len(r.Ingredients))
+	var assign func(i int) bool
+	assign = func(i int) bool {
+		if i == len(items) {
+			return true
+		}
+		for j, ing := range r.Ingredients {
+			if !used[j] && ing.Match(items[i]) {
+				used[j] = true
+				if assign(i + 1) {
+					return true
+				}
+				used[j] = false
+			}
+		}
+		return false
+	}
+	return assign(0)
+}
+
+// Smelting is a furnace recipe.
+type Smelting struct {
+	Input  Choice
+	Result stream.Slot
+	// Experience is given when the result is taken out
+	Experience float32
+}
+
+// All return all crafting recipes in order of id, which is the order they are matched like vanilla.
+func All() []*Recipe {
+	recipes := make([]*Recipe, len(crafting))
+	for i := range crafting {
+		recipes[i] = &crafting[i]
+	}
+	return recipes
+}
+
+// ByID return crafting recipe of id, nil if not found.
+func ByID(id int32) *Recipe {
+	i := int(id) - firstID
+	if i < 0 || i >= len(crafting) {
+		return nil
+	}
+	return &crafting[i]
+}
+
+// Match return the crafting recipe of items in a size x size grid, nil if nothing is crafted.
+func Match(grid []stream.Slot, size int) *Recipe {
+	for i := range crafting {
+		if crafting[i].Match(grid, size) {
+			return &crafting[i]
+		}
+	}
+	return nil
+}
+
+// Smelt return the furnace recipe of it, nil if it can't be smelted.
+func Smelt(it stream.Slot) *Smelting {
+	for i := range smelting {
+		if (Ingredient{smelting[i].Input}).Match(it) {
+			return &smelting[i]
+		}
+	}
+	return nil
+}
+
+// FuelTicks return how long it burns in a furnace, 0 if it is not fuel.
+func FuelTicks(it stream.Slot) int {
+	if it.Empty() {
+		return 0
+	}
+	return fuels[uint16(it.ID)]
+}
+
+// Remainder return what is left in grid or furnace after it is used, like the bucket of milk.
+func Remainder(it stream.Slot) stream.Slot {
+	switch uint16(it.ID) {
+	case item.MilkBucket, item.WaterBucket, item.LavaBucket:
+		return stream.Slot{ID: int16(item.Bucket), Count: 1}
+	}
+	return stream.EmptySlot
+}
@@ -0,0 +1,1426 @@
+// Code generated by "go run gen_recipes.go"; DO NOT EDIT.
+
+package recipe
+
+import (
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/stream"
+)
+
+// firstID is id of the first recipe in crafting
+const firstID = 11
+
+var crafting = []Recipe{
+	{ID: 11, Name: "yellow_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 11}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 4}},
+	{ID: 12, Name: "yellow_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 11}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 4}},
+	{ID: 13, Name: "yellow_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 4}}, {{ID: item.StainedGlass, Damage: 4}}, {{ID: item.StainedGlass, Damage: 4}}, {{ID: item.StainedGlass, Damage: 4}}, {{ID: item.StainedGlass, Damage: 4}}, {{ID: item.StainedGlass, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 4}},
+	{ID: 14, Name: "yellow_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 11}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 4}},
+	{ID: 15, Name: "yellow_dye_from_sunflower", Group: "yellow_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.DoublePlant, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 11}},
+	{ID: 16, Name: "yellow_dye_from_dandelion", Group: "yellow_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.YellowFlower, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 11}},
+	{ID: 17, Name: "yellow_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 11}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 4}},
+	{ID: 18, Name: "yellow_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 4}},
+	{ID: 19, Name: "yellow_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 11}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 4}},
+	{ID: 20, Name: "yellow_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 4}},
+	{ID: 21, Name: "yellow_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}}, {{ID: item.Wool, Damage: 4}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 11}},
+	{ID: 22, Name: "writable_book", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Book, Damage: 0}}, {{ID: item.Dye, Damage: 0}}, {{ID: item.Feather, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.WritableBook), Count: 1, Damage: 0}},
+	{ID: 23, Name: "wooden_sword", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.WoodenSword), Count: 1, Damage: 0}},
+	{ID: 24, Name: "wooden_shovel", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.WoodenShovel), Count: 1, Damage: 0}},
+	{ID: 25, Name: "wooden_pressure_plate", Group: "", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.WoodenPressurePlate), Count: 1, Damage: 0}},
+	{ID: 26, Name: "wooden_pickaxe", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, nil, {{ID: item.Stick, Damage: 0}}, nil, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.WoodenPickaxe), Count: 1, Damage: 0}},
+	{ID: 27, Name: "wooden_hoe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, nil, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.WoodenHoe), Count: 1, Damage: 0}},
+	{ID: 28, Name: "wooden_door", Group: "wooden_door", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.WoodenDoor), Count: 3, Damage: 0}},
+	{ID: 29, Name: "wooden_button", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.WoodenButton), Count: 1, Damage: 0}},
+	{ID: 30, Name: "wooden_axe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.WoodenAxe), Count: 1, Damage: 0}},
+	{ID: 31, Name: "white_wool_from_string", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.String, Damage: 0}}, {{ID: item.String, Damage: 0}}, {{ID: item.String, Damage: 0}}, {{ID: item.String, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 0}},
+	{ID: 32, Name: "white_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 0}},
+	{ID: 33, Name: "white_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 0}}, {{ID: item.StainedGlass, Damage: 0}}, {{ID: item.StainedGlass, Damage: 0}}, {{ID: item.StainedGlass, Damage: 0}}, {{ID: item.StainedGlass, Damage: 0}}, {{ID: item.StainedGlass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 0}},
+	{ID: 34, Name: "white_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 0}},
+	{ID: 35, Name: "white_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 15}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 0}},
+	{ID: 36, Name: "white_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 0}},
+	{ID: 37, Name: "white_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 0}},
+	{ID: 38, Name: "white_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}}, {{ID: item.Wool, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 15}},
+	{ID: 39, Name: "wheat", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.HayBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wheat), Count: 9, Damage: 0}},
+	{ID: 40, Name: "tripwire_hook", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.TripwireHook), Count: 2, Damage: 0}},
+	{ID: 41, Name: "trapped_chest", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Chest, Damage: 0}}, {{ID: item.TripwireHook, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.TrappedChest), Count: 1, Damage: 0}},
+	{ID: 42, Name: "trapdoor", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Trapdoor), Count: 2, Damage: 0}},
+	{ID: 43, Name: "torch", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Coal, Damage: 0}, {ID: item.Coal, Damage: 1}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Torch), Count: 4, Damage: 0}},
+	{ID: 44, Name: "tnt_minecart", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Tnt, Damage: 0}}, {{ID: item.Minecart, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.TntMinecart), Count: 1, Damage: 0}},
+	{ID: 45, Name: "tnt", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Gunpowder, Damage: 0}}, {{ID: item.Sand, Damage: 32767}}, {{ID: item.Gunpowder, Damage: 0}}, {{ID: item.Sand, Damage: 32767}}, {{ID: item.Gunpowder, Damage: 0}}, {{ID: item.Sand, Damage: 32767}}, {{ID: item.Gunpowder, Damage: 0}}, {{ID: item.Sand, Damage: 32767}}, {{ID: item.Gunpowder, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Tnt), Count: 1, Damage: 0}},
+	{ID: 46, Name: "sugar", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Reeds, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Sugar), Count: 1, Damage: 0}},
+	{ID: 47, Name: "stonebrick", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Stonebrick), Count: 4, Damage: 0}},
+	{ID: 48, Name: "stone_sword", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneSword), Count: 1, Damage: 0}},
+	{ID: 49, Name: "stone_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab), Count: 6, Damage: 0}},
+	{ID: 50, Name: "stone_shovel", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneShovel), Count: 1, Damage: 0}},
+	{ID: 51, Name: "stone_pressure_plate", Group: "", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StonePressurePlate), Count: 1, Damage: 0}},
+	{ID: 52, Name: "stone_pickaxe", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.StonePickaxe), Count: 1, Damage: 0}},
+	{ID: 53, Name: "stone_hoe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneHoe), Count: 1, Damage: 0}},
+	{ID: 54, Name: "stone_button", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneButton), Count: 1, Damage: 0}},
+	{ID: 55, Name: "stone_brick_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Stonebrick, Damage: 32767}}, nil, nil, {{ID: item.Stonebrick, Damage: 32767}}, {{ID: item.Stonebrick, Damage: 32767}}, nil, {{ID: item.Stonebrick, Damage: 32767}}, {{ID: item.Stonebrick, Damage: 32767}}, {{ID: item.Stonebrick, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.StoneBrickStairs), Count: 4, Damage: 0}},
+	{ID: 56, Name: "stone_brick_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Stonebrick, Damage: 0}}, {{ID: item.Stonebrick, Damage: 0}}, {{ID: item.Stonebrick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab), Count: 6, Damage: 5}},
+	{ID: 57, Name: "stone_axe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneAxe), Count: 1, Damage: 0}},
+	{ID: 58, Name: "sticky_piston", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.SlimeBall, Damage: 0}}, {{ID: item.Piston, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StickyPiston), Count: 1, Damage: 0}},
+	{ID: 59, Name: "stick", Group: "sticks", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Stick), Count: 4, Damage: 0}},
+	{ID: 60, Name: "spruce_wooden_slab", Group: "wooden_slab", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.WoodenSlab), Count: 6, Damage: 1}},
+	{ID: 61, Name: "spruce_stairs", Group: "wooden_stairs", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 1}}, nil, nil, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, nil, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.SpruceStairs), Count: 4, Damage: 0}},
+	{ID: 62, Name: "spruce_planks", Group: "planks", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Log, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Planks), Count: 4, Damage: 1}},
+	{ID: 63, Name: "spruce_fence_gate", Group: "wooden_fence_gate", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.SpruceFenceGate), Count: 1, Damage: 0}},
+	{ID: 64, Name: "spruce_fence", Group: "wooden_fence", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 1}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.SpruceFence), Count: 3, Damage: 0}},
+	{ID: 65, Name: "spruce_door", Group: "wooden_door", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.SpruceDoor), Count: 3, Damage: 0}},
+	{ID: 66, Name: "spruce_boat", Group: "boat", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 1}}, nil, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}}, {{ID: item.Planks, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.SpruceBoat), Count: 1, Damage: 0}},
+	{ID: 67, Name: "spectral_arrow", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.GlowstoneDust, Damage: 0}}, nil, {{ID: item.GlowstoneDust, Damage: 0}}, {{ID: item.Arrow, Damage: 0}}, {{ID: item.GlowstoneDust, Damage: 0}}, nil, {{ID: item.GlowstoneDust, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.SpectralArrow), Count: 2, Damage: 0}},
+	{ID: 68, Name: "speckled_melon", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.SpeckledMelon), Count: 1, Damage: 0}},
+	{ID: 69, Name: "snow_layer", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Snow, Damage: 0}}, {{ID: item.Snow, Damage: 0}}, {{ID: item.Snow, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.SnowLayer), Count: 6, Damage: 0}},
+	{ID: 70, Name: "snow", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Snowball, Damage: 0}}, {{ID: item.Snowball, Damage: 0}}, {{ID: item.Snowball, Damage: 0}}, {{ID: item.Snowball, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Snow), Count: 1, Damage: 0}},
+	{ID: 71, Name: "smooth_sandstone", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Sandstone, Damage: 0}}, {{ID: item.Sandstone, Damage: 0}}, {{ID: item.Sandstone, Damage: 0}}, {{ID: item.Sandstone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Sandstone), Count: 4, Damage: 2}},
+	{ID: 72, Name: "smooth_red_sandstone", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.RedSandstone, Damage: 0}}, {{ID: item.RedSandstone, Damage: 0}}, {{ID: item.RedSandstone, Damage: 0}}, {{ID: item.RedSandstone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.RedSandstone), Count: 4, Damage: 2}},
+	{ID: 73, Name: "slime_ball", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Slime, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.SlimeBall), Count: 9, Damage: 0}},
+	{ID: 74, Name: "slime", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Slime), Count: 1, Damage: 0}},
+	{ID: 75, Name: "sign", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Sign), Count: 3, Damage: 0}},
+	{ID: 76, Name: "shield", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, nil, {{ID: item.Planks, Damage: 32767}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Shield), Count: 1, Damage: 0}},
+	{ID: 77, Name: "shears", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Shears), Count: 1, Damage: 0}},
+	{ID: 78, Name: "sea_lantern", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineCrystals, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineCrystals, Damage: 0}}, {{ID: item.PrismarineCrystals, Damage: 0}}, {{ID: item.PrismarineCrystals, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineCrystals, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.SeaLantern), Count: 1, Damage: 0}},
+	{ID: 79, Name: "sandstone_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Sandstone, Damage: 32767}}, nil, nil, {{ID: item.Sandstone, Damage: 32767}}, {{ID: item.Sandstone, Damage: 32767}}, nil, {{ID: item.Sandstone, Damage: 32767}}, {{ID: item.Sandstone, Damage: 32767}}, {{ID: item.Sandstone, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.SandstoneStairs), Count: 4, Damage: 0}},
+	{ID: 80, Name: "sandstone_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Sandstone, Damage: 32767}}, {{ID: item.Sandstone, Damage: 32767}}, {{ID: item.Sandstone, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab), Count: 6, Damage: 1}},
+	{ID: 81, Name: "sandstone", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Sandstone), Count: 1, Damage: 0}},
+	{ID: 82, Name: "repeater", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.RedstoneTorch, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.RedstoneTorch, Damage: 0}}, {{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Repeater), Count: 1, Damage: 0}},
+	{ID: 83, Name: "redstone_torch", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Redstone, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.RedstoneTorch), Count: 1, Damage: 0}},
+	{ID: 84, Name: "redstone_lamp", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.Redstone, Damage: 0}}, nil, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Glowstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, nil, {{ID: item.Redstone, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.RedstoneLamp), Count: 1, Damage: 0}},
+	{ID: 85, Name: "redstone_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.RedstoneBlock), Count: 1, Damage: 0}},
+	{ID: 86, Name: "redstone", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedstoneBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Redstone), Count: 9, Damage: 0}},
+	{ID: 87, Name: "red_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 1}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 14}},
+	{ID: 88, Name: "red_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 1}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 14}},
+	{ID: 89, Name: "red_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 14}}, {{ID: item.StainedGlass, Damage: 14}}, {{ID: item.StainedGlass, Damage: 14}}, {{ID: item.StainedGlass, Damage: 14}}, {{ID: item.StainedGlass, Damage: 14}}, {{ID: item.StainedGlass, Damage: 14}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 14}},
+	{ID: 90, Name: "red_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 1}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 14}},
+	{ID: 91, Name: "red_sandstone_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.RedSandstone, Damage: 32767}}, nil, nil, {{ID: item.RedSandstone, Damage: 32767}}, {{ID: item.RedSandstone, Damage: 32767}}, nil, {{ID: item.RedSandstone, Damage: 32767}}, {{ID: item.RedSandstone, Damage: 32767}}, {{ID: item.RedSandstone, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.RedSandstoneStairs), Count: 4, Damage: 0}},
+	{ID: 92, Name: "red_sandstone_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.RedSandstone, Damage: 32767}}, {{ID: item.RedSandstone, Damage: 32767}}, {{ID: item.RedSandstone, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab2), Count: 6, Damage: 0}},
+	{ID: 93, Name: "red_sandstone", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Sand, Damage: 1}}, {{ID: item.Sand, Damage: 1}}, {{ID: item.Sand, Damage: 1}}, {{ID: item.Sand, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.RedSandstone), Count: 1, Damage: 0}},
+	{ID: 94, Name: "red_nether_brick", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Netherbrick, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.Netherbrick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.RedNetherBrick), Count: 1, Damage: 0}},
+	{ID: 95, Name: "red_dye_from_tulip", Group: "red_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 1}},
+	{ID: 96, Name: "red_dye_from_rose_bush", Group: "red_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.DoublePlant, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 1}},
+	{ID: 97, Name: "red_dye_from_poppy", Group: "red_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 1}},
+	{ID: 98, Name: "red_dye_from_beetroot", Group: "red_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Beetroot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 1}},
+	{ID: 99, Name: "red_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 1}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 14}},
+	{ID: 100, Name: "red_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 14}},
+	{ID: 101, Name: "red_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 14}},
+	{ID: 102, Name: "red_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 14}},
+	{ID: 103, Name: "red_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}}, {{ID: item.Wool, Damage: 14}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 1}},
+	{ID: 104, Name: "rail", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Rail), Count: 16, Damage: 0}},
+	{ID: 105, Name: "rabbit_stew_from_red_mushroom", Group: "rabbit_stew", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.CookedRabbit, Damage: 0}}, nil, {{ID: item.Carrot, Damage: 0}}, {{ID: item.BakedPotato, Damage: 0}}, {{ID: item.RedMushroom, Damage: 0}}, nil, {{ID: item.Bowl, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.RabbitStew), Count: 1, Damage: 0}},
+	{ID: 106, Name: "rabbit_stew_from_brown_mushroom", Group: "rabbit_stew", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.CookedRabbit, Damage: 0}}, nil, {{ID: item.Carrot, Damage: 0}}, {{ID: item.BakedPotato, Damage: 0}}, {{ID: item.BrownMushroom, Damage: 0}}, nil, {{ID: item.Bowl, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.RabbitStew), Count: 1, Damage: 0}},
+	{ID: 107, Name: "quartz_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.QuartzBlock, Damage: 32767}}, nil, nil, {{ID: item.QuartzBlock, Damage: 32767}}, {{ID: item.QuartzBlock, Damage: 32767}}, nil, {{ID: item.QuartzBlock, Damage: 32767}}, {{ID: item.QuartzBlock, Damage: 32767}}, {{ID: item.QuartzBlock, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.QuartzStairs), Count: 4, Damage: 0}},
+	{ID: 108, Name: "quartz_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.QuartzBlock, Damage: 32767}}, {{ID: item.QuartzBlock, Damage: 32767}}, {{ID: item.QuartzBlock, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab), Count: 6, Damage: 7}},
+	{ID: 109, Name: "quartz_block", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Quartz, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.Quartz, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.QuartzBlock), Count: 1, Damage: 0}},
+	{ID: 110, Name: "purpur_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.PurpurBlock, Damage: 0}}, nil, nil, {{ID: item.PurpurBlock, Damage: 0}}, {{ID: item.PurpurBlock, Damage: 0}}, nil, {{ID: item.PurpurBlock, Damage: 0}}, {{ID: item.PurpurBlock, Damage: 0}}, {{ID: item.PurpurBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.PurpurStairs), Count: 4, Damage: 0}},
+	{ID: 111, Name: "purpur_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.PurpurBlock, Damage: 0}}, {{ID: item.PurpurBlock, Damage: 0}}, {{ID: item.PurpurBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.PurpurSlab), Count: 6, Damage: 0}},
+	{ID: 112, Name: "purpur_pillar", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.PurpurSlab, Damage: 0}}, {{ID: item.PurpurSlab, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.PurpurPillar), Count: 1, Damage: 0}},
+	{ID: 113, Name: "purpur_block", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.ChorusFruitPopped, Damage: 0}}, {{ID: item.ChorusFruitPopped, Damage: 0}}, {{ID: item.ChorusFruitPopped, Damage: 0}}, {{ID: item.ChorusFruitPopped, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.PurpurBlock), Count: 4, Damage: 0}},
+	{ID: 114, Name: "purple_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 5}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 10}},
+	{ID: 115, Name: "purple_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 5}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 10}},
+	{ID: 116, Name: "purple_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 10}}, {{ID: item.StainedGlass, Damage: 10}}, {{ID: item.StainedGlass, Damage: 10}}, {{ID: item.StainedGlass, Damage: 10}}, {{ID: item.StainedGlass, Damage: 10}}, {{ID: item.StainedGlass, Damage: 10}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 10}},
+	{ID: 117, Name: "purple_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 5}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 10}},
+	{ID: 118, Name: "purple_shulker_box", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.ShulkerShell, Damage: 0}}, {{ID: item.Chest, Damage: 0}}, {{ID: item.ShulkerShell, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.PurpleShulkerBox), Count: 1, Damage: 0}},
+	{ID: 119, Name: "purple_dye", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 5}},
+	{ID: 120, Name: "purple_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 5}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 10}},
+	{ID: 121, Name: "purple_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 10}},
+	{ID: 122, Name: "purple_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 10}},
+	{ID: 123, Name: "purple_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 10}},
+	{ID: 124, Name: "purple_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}}, {{ID: item.Wool, Damage: 10}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 5}},
+	{ID: 125, Name: "pumpkin_seeds", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Pumpkin, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.PumpkinSeeds), Count: 4, Damage: 0}},
+	{ID: 126, Name: "pumpkin_pie", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Pumpkin, Damage: 0}}, {{ID: item.Sugar, Damage: 0}}, {{ID: item.Egg, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.PumpkinPie), Count: 1, Damage: 0}},
+	{ID: 127, Name: "prismarine_bricks", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Prismarine), Count: 1, Damage: 1}},
+	{ID: 128, Name: "prismarine", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Prismarine), Count: 1, Damage: 0}},
+	{ID: 129, Name: "polished_granite", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 1}}, {{ID: item.Stone, Damage: 1}}, {{ID: item.Stone, Damage: 1}}, {{ID: item.Stone, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Stone), Count: 4, Damage: 2}},
+	{ID: 130, Name: "polished_diorite", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 3}}, {{ID: item.Stone, Damage: 3}}, {{ID: item.Stone, Damage: 3}}, {{ID: item.Stone, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.Stone), Count: 4, Damage: 4}},
+	{ID: 131, Name: "polished_andesite", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 5}}, {{ID: item.Stone, Damage: 5}}, {{ID: item.Stone, Damage: 5}}, {{ID: item.Stone, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.Stone), Count: 4, Damage: 6}},
+	{ID: 132, Name: "piston", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Piston), Count: 1, Damage: 0}},
+	{ID: 133, Name: "pink_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 9}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 6}},
+	{ID: 134, Name: "pink_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 9}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 6}},
+	{ID: 135, Name: "pink_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 6}}, {{ID: item.StainedGlass, Damage: 6}}, {{ID: item.StainedGlass, Damage: 6}}, {{ID: item.StainedGlass, Damage: 6}}, {{ID: item.StainedGlass, Damage: 6}}, {{ID: item.StainedGlass, Damage: 6}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 6}},
+	{ID: 136, Name: "pink_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 9}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 6}},
+	{ID: 137, Name: "pink_dye_from_red_bonemeal", Group: "pink_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 1}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 9}},
+	{ID: 138, Name: "pink_dye_from_pink_tulip", Group: "pink_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 7}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 9}},
+	{ID: 139, Name: "pink_dye_from_peony", Group: "pink_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.DoublePlant, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 9}},
+	{ID: 140, Name: "pink_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 9}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 6}},
+	{ID: 141, Name: "pink_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 6}},
+	{ID: 142, Name: "pink_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 9}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 6}},
+	{ID: 143, Name: "pink_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 6}},
+	{ID: 144, Name: "pink_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}}, {{ID: item.Wool, Damage: 6}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 9}},
+	{ID: 145, Name: "pillar_quartz_block", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.QuartzBlock, Damage: 0}}, {{ID: item.QuartzBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.QuartzBlock), Count: 2, Damage: 2}},
+	{ID: 146, Name: "paper", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Reeds, Damage: 0}}, {{ID: item.Reeds, Damage: 0}}, {{ID: item.Reeds, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Paper), Count: 3, Damage: 0}},
+	{ID: 147, Name: "painting", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Wool, Damage: 32767}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Painting), Count: 1, Damage: 0}},
+	{ID: 148, Name: "orange_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 14}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 1}},
+	{ID: 149, Name: "orange_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 14}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 1}},
+	{ID: 150, Name: "orange_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 1}}, {{ID: item.StainedGlass, Damage: 1}}, {{ID: item.StainedGlass, Damage: 1}}, {{ID: item.StainedGlass, Damage: 1}}, {{ID: item.StainedGlass, Damage: 1}}, {{ID: item.StainedGlass, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 1}},
+	{ID: 151, Name: "orange_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 14}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 1}},
+	{ID: 152, Name: "orange_dye_from_red_yellow", Group: "orange_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 1}}, {{ID: item.Dye, Damage: 11}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 14}},
+	{ID: 153, Name: "orange_dye_from_orange_tulip", Group: "orange_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 14}},
+	{ID: 154, Name: "orange_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 14}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 1}},
+	{ID: 155, Name: "orange_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 1}},
+	{ID: 156, Name: "orange_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 14}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 1}},
+	{ID: 157, Name: "orange_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 1}},
+	{ID: 158, Name: "orange_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}}, {{ID: item.Wool, Damage: 1}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 14}},
+	{ID: 159, Name: "observer", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Observer), Count: 1, Damage: 0}},
+	{ID: 160, Name: "oak_wooden_slab", Group: "wooden_slab", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.WoodenSlab), Count: 6, Damage: 0}},
+	{ID: 161, Name: "oak_stairs", Group: "wooden_stairs", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 0}}, nil, nil, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, nil, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.OakStairs), Count: 4, Damage: 0}},
+	{ID: 162, Name: "oak_planks", Group: "planks", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Log, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Planks), Count: 4, Damage: 0}},
+	{ID: 163, Name: "noteblock", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Noteblock), Count: 1, Damage: 0}},
+	{ID: 164, Name: "nether_wart_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}}, {{ID: item.NetherWart, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.NetherWartBlock), Count: 1, Damage: 0}},
+	{ID: 165, Name: "nether_brick_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.NetherBrick, Damage: 0}}, nil, nil, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}}, nil, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.NetherBrickStairs), Count: 4, Damage: 0}},
+	{ID: 166, Name: "nether_brick_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab), Count: 6, Damage: 6}},
+	{ID: 167, Name: "nether_brick_fence", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}}, {{ID: item.NetherBrick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.NetherBrickFence), Count: 6, Damage: 0}},
+	{ID: 168, Name: "nether_brick", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Netherbrick, Damage: 0}}, {{ID: item.Netherbrick, Damage: 0}}, {{ID: item.Netherbrick, Damage: 0}}, {{ID: item.Netherbrick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.NetherBrick), Count: 1, Damage: 0}},
+	{ID: 169, Name: "mushroom_stew", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.BrownMushroom, Damage: 0}}, {{ID: item.RedMushroom, Damage: 0}}, {{ID: item.Bowl, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.MushroomStew), Count: 1, Damage: 0}},
+	{ID: 170, Name: "mossy_stonebrick", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Stonebrick, Damage: 0}}, {{ID: item.Vine, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Stonebrick), Count: 1, Damage: 1}},
+	{ID: 171, Name: "mossy_cobblestone_wall", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.MossyCobblestone, Damage: 0}}, {{ID: item.MossyCobblestone, Damage: 0}}, {{ID: item.MossyCobblestone, Damage: 0}}, {{ID: item.MossyCobblestone, Damage: 0}}, {{ID: item.MossyCobblestone, Damage: 0}}, {{ID: item.MossyCobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.CobblestoneWall), Count: 6, Damage: 1}},
+	{ID: 172, Name: "mossy_cobblestone", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Vine, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.MossyCobblestone), Count: 1, Damage: 0}},
+	{ID: 173, Name: "minecart", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Minecart), Count: 1, Damage: 0}},
+	{ID: 174, Name: "melon_seeds", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Melon, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.MelonSeeds), Count: 1, Damage: 0}},
+	{ID: 175, Name: "melon_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}}, {{ID: item.Melon, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.MelonBlock), Count: 1, Damage: 0}},
+	{ID: 176, Name: "map", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Compass, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Map), Count: 1, Damage: 0}},
+	{ID: 177, Name: "magma_cream", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.BlazePowder, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.MagmaCream), Count: 1, Damage: 0}},
+	{ID: 178, Name: "magma", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.MagmaCream, Damage: 0}}, {{ID: item.MagmaCream, Damage: 0}}, {{ID: item.MagmaCream, Damage: 0}}, {{ID: item.MagmaCream, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Magma), Count: 1, Damage: 0}},
+	{ID: 179, Name: "magenta_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 13}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 2}},
+	{ID: 180, Name: "magenta_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 13}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 2}},
+	{ID: 181, Name: "magenta_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 2}}, {{ID: item.StainedGlass, Damage: 2}}, {{ID: item.StainedGlass, Damage: 2}}, {{ID: item.StainedGlass, Damage: 2}}, {{ID: item.StainedGlass, Damage: 2}}, {{ID: item.StainedGlass, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 2}},
+	{ID: 182, Name: "magenta_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 13}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 2}},
+	{ID: 183, Name: "magenta_dye_from_purple_and_pink", Group: "magenta_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 5}}, {{ID: item.Dye, Damage: 9}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 13}},
+	{ID: 184, Name: "magenta_dye_from_lilac", Group: "magenta_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.DoublePlant, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 13}},
+	{ID: 185, Name: "magenta_dye_from_lapis_red_pink", Group: "magenta_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 1}}, {{ID: item.Dye, Damage: 9}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 3, Damage: 13}},
+	{ID: 186, Name: "magenta_dye_from_lapis_red_bonemeal", Group: "magenta_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 1}}, {{ID: item.Dye, Damage: 1}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 4, Damage: 13}},
+	{ID: 187, Name: "magenta_dye_from_allium", Group: "magenta_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 13}},
+	{ID: 188, Name: "magenta_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 13}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 2}},
+	{ID: 189, Name: "magenta_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 2}},
+	{ID: 190, Name: "magenta_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 13}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 2}},
+	{ID: 191, Name: "magenta_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 2}},
+	{ID: 192, Name: "magenta_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}}, {{ID: item.Wool, Damage: 2}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 13}},
+	{ID: 193, Name: "lit_pumpkin", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Pumpkin, Damage: 0}}, {{ID: item.Torch, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.LitPumpkin), Count: 1, Damage: 0}},
+	{ID: 194, Name: "lime_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 10}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 5}},
+	{ID: 195, Name: "lime_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 10}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 5}},
+	{ID: 196, Name: "lime_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 5}}, {{ID: item.StainedGlass, Damage: 5}}, {{ID: item.StainedGlass, Damage: 5}}, {{ID: item.StainedGlass, Damage: 5}}, {{ID: item.StainedGlass, Damage: 5}}, {{ID: item.StainedGlass, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 5}},
+	{ID: 197, Name: "lime_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 10}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 5}},
+	{ID: 198, Name: "lime_dye", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 2}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 10}},
+	{ID: 199, Name: "lime_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 10}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 5}},
+	{ID: 200, Name: "lime_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 5}},
+	{ID: 201, Name: "lime_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 10}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 5}},
+	{ID: 202, Name: "lime_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 5}},
+	{ID: 203, Name: "lime_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}}, {{ID: item.Wool, Damage: 5}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 10}},
+	{ID: 204, Name: "light_weighted_pressure_plate", Group: "", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.LightWeightedPressurePlate), Count: 1, Damage: 0}},
+	{ID: 205, Name: "light_gray_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 7}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 8}},
+	{ID: 206, Name: "light_gray_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 7}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 8}},
+	{ID: 207, Name: "light_gray_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 8}}, {{ID: item.StainedGlass, Damage: 8}}, {{ID: item.StainedGlass, Damage: 8}}, {{ID: item.StainedGlass, Damage: 8}}, {{ID: item.StainedGlass, Damage: 8}}, {{ID: item.StainedGlass, Damage: 8}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 8}},
+	{ID: 208, Name: "light_gray_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 7}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 8}},
+	{ID: 209, Name: "light_gray_dye_from_white_tulip", Group: "light_gray_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 6}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 7}},
+	{ID: 210, Name: "light_gray_dye_from_oxeye_daisy", Group: "light_gray_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 8}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 7}},
+	{ID: 211, Name: "light_gray_dye_from_ink_bonemeal", Group: "light_gray_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 0}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 3, Damage: 7}},
+	{ID: 212, Name: "light_gray_dye_from_gray_bonemeal", Group: "light_gray_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 8}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 7}},
+	{ID: 213, Name: "light_gray_dye_from_azure_bluet", Group: "light_gray_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 7}},
+	{ID: 214, Name: "light_gray_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 7}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 8}},
+	{ID: 215, Name: "light_gray_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 8}},
+	{ID: 216, Name: "light_gray_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 7}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 8}},
+	{ID: 217, Name: "light_gray_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 8}},
+	{ID: 218, Name: "light_gray_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}}, {{ID: item.Wool, Damage: 8}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 7}},
+	{ID: 219, Name: "light_blue_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 12}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 3}},
+	{ID: 220, Name: "light_blue_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 12}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 3}},
+	{ID: 221, Name: "light_blue_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 3}}, {{ID: item.StainedGlass, Damage: 3}}, {{ID: item.StainedGlass, Damage: 3}}, {{ID: item.StainedGlass, Damage: 3}}, {{ID: item.StainedGlass, Damage: 3}}, {{ID: item.StainedGlass, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 3}},
+	{ID: 222, Name: "light_blue_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 12}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 3}},
+	{ID: 223, Name: "light_blue_dye_from_lapis_bonemeal", Group: "light_blue_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 12}},
+	{ID: 224, Name: "light_blue_dye_from_blue_orchid", Group: "light_blue_dye", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.RedFlower, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 12}},
+	{ID: 225, Name: "light_blue_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 12}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 3}},
+	{ID: 226, Name: "light_blue_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 3}},
+	{ID: 227, Name: "light_blue_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 12}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 3}},
+	{ID: 228, Name: "light_blue_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 3}},
+	{ID: 229, Name: "light_blue_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}}, {{ID: item.Wool, Damage: 3}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 12}},
+	{ID: 230, Name: "lever", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Lever), Count: 1, Damage: 0}},
+	{ID: 231, Name: "leather_leggings", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, nil, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, nil, {{ID: item.Leather, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.LeatherLeggings), Count: 1, Damage: 0}},
+	{ID: 232, Name: "leather_helmet", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, nil, {{ID: item.Leather, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.LeatherHelmet), Count: 1, Damage: 0}},
+	{ID: 233, Name: "leather_chestplate", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Leather, Damage: 0}}, nil, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.LeatherChestplate), Count: 1, Damage: 0}},
+	{ID: 234, Name: "leather_boots", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Leather, Damage: 0}}, nil, {{ID: item.Leather, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, nil, {{ID: item.Leather, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.LeatherBoots), Count: 1, Damage: 0}},
+	{ID: 235, Name: "leather", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.RabbitHide, Damage: 0}}, {{ID: item.RabbitHide, Damage: 0}}, {{ID: item.RabbitHide, Damage: 0}}, {{ID: item.RabbitHide, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Leather), Count: 1, Damage: 0}},
+	{ID: 236, Name: "lead", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.String, Damage: 0}}, {{ID: item.String, Damage: 0}}, nil, {{ID: item.String, Damage: 0}}, {{ID: item.SlimeBall, Damage: 0}}, nil, nil, nil, {{ID: item.String, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Lead), Count: 2, Damage: 0}},
+	{ID: 237, Name: "lapis_lazuli", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.LapisBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 9, Damage: 4}},
+	{ID: 238, Name: "lapis_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.LapisBlock), Count: 1, Damage: 0}},
+	{ID: 239, Name: "ladder", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Ladder), Count: 3, Damage: 0}},
+	{ID: 240, Name: "jungle_wooden_slab", Group: "wooden_slab", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.WoodenSlab), Count: 6, Damage: 3}},
+	{ID: 241, Name: "jungle_stairs", Group: "wooden_stairs", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 3}}, nil, nil, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, nil, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.JungleStairs), Count: 4, Damage: 0}},
+	{ID: 242, Name: "jungle_planks", Group: "planks", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Log, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.Planks), Count: 4, Damage: 3}},
+	{ID: 243, Name: "jungle_fence_gate", Group: "wooden_fence_gate", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.JungleFenceGate), Count: 1, Damage: 0}},
+	{ID: 244, Name: "jungle_fence", Group: "wooden_fence", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 3}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.JungleFence), Count: 3, Damage: 0}},
+	{ID: 245, Name: "jungle_door", Group: "wooden_door", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.JungleDoor), Count: 3, Damage: 0}},
+	{ID: 246, Name: "jungle_boat", Group: "boat", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 3}}, nil, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}}, {{ID: item.Planks, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.JungleBoat), Count: 1, Damage: 0}},
+	{ID: 247, Name: "jukebox", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Jukebox), Count: 1, Damage: 0}},
+	{ID: 248, Name: "item_frame", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Leather, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ItemFrame), Count: 1, Damage: 0}},
+	{ID: 249, Name: "iron_trapdoor", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronTrapdoor), Count: 1, Damage: 0}},
+	{ID: 250, Name: "iron_sword", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronSword), Count: 1, Damage: 0}},
+	{ID: 251, Name: "iron_shovel", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronShovel), Count: 1, Damage: 0}},
+	{ID: 252, Name: "iron_pickaxe", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.IronPickaxe), Count: 1, Damage: 0}},
+	{ID: 253, Name: "iron_nugget", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 9, Damage: 0}},
+	{ID: 254, Name: "iron_leggings", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronLeggings), Count: 1, Damage: 0}},
+	{ID: 255, Name: "iron_ingot_from_nuggets", Group: "iron_ingot", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}}, {{ID: item.IronNugget, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronIngot), Count: 1, Damage: 0}},
+	{ID: 256, Name: "iron_ingot_from_block", Group: "iron_ingot", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.IronBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronIngot), Count: 9, Damage: 0}},
+	{ID: 257, Name: "iron_hoe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronHoe), Count: 1, Damage: 0}},
+	{ID: 258, Name: "iron_helmet", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronHelmet), Count: 1, Damage: 0}},
+	{ID: 259, Name: "iron_door", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronDoor), Count: 3, Damage: 0}},
+	{ID: 260, Name: "iron_chestplate", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronChestplate), Count: 1, Damage: 0}},
+	{ID: 261, Name: "iron_boots", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronBoots), Count: 1, Damage: 0}},
+	{ID: 262, Name: "iron_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronBlock), Count: 1, Damage: 0}},
+	{ID: 263, Name: "iron_bars", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronBars), Count: 16, Damage: 0}},
+	{ID: 264, Name: "iron_axe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.IronAxe), Count: 1, Damage: 0}},
+	{ID: 265, Name: "hopper_minecart", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Hopper, Damage: 0}}, {{ID: item.Minecart, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.HopperMinecart), Count: 1, Damage: 0}},
+	{ID: 266, Name: "hopper", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Chest, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Hopper), Count: 1, Damage: 0}},
+	{ID: 267, Name: "heavy_weighted_pressure_plate", Group: "", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.HeavyWeightedPressurePlate), Count: 1, Damage: 0}},
+	{ID: 268, Name: "hay_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.HayBlock), Count: 1, Damage: 0}},
+	{ID: 269, Name: "green_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 2}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 13}},
+	{ID: 270, Name: "green_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 2}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 13}},
+	{ID: 271, Name: "green_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 13}}, {{ID: item.StainedGlass, Damage: 13}}, {{ID: item.StainedGlass, Damage: 13}}, {{ID: item.StainedGlass, Damage: 13}}, {{ID: item.StainedGlass, Damage: 13}}, {{ID: item.StainedGlass, Damage: 13}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 13}},
+	{ID: 272, Name: "green_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 2}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 13}},
+	{ID: 273, Name: "green_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 2}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 13}},
+	{ID: 274, Name: "green_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 13}},
+	{ID: 275, Name: "green_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 13}},
+	{ID: 276, Name: "green_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 13}},
+	{ID: 277, Name: "green_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}}, {{ID: item.Wool, Damage: 13}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 2}},
+	{ID: 278, Name: "gray_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 8}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 7}},
+	{ID: 279, Name: "gray_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 8}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 7}},
+	{ID: 280, Name: "gray_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 7}}, {{ID: item.StainedGlass, Damage: 7}}, {{ID: item.StainedGlass, Damage: 7}}, {{ID: item.StainedGlass, Damage: 7}}, {{ID: item.StainedGlass, Damage: 7}}, {{ID: item.StainedGlass, Damage: 7}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 7}},
+	{ID: 281, Name: "gray_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 8}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 7}},
+	{ID: 282, Name: "gray_dye", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 0}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 8}},
+	{ID: 283, Name: "gray_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 8}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 7}},
+	{ID: 284, Name: "gray_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 7}},
+	{ID: 285, Name: "gray_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 8}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 7}},
+	{ID: 286, Name: "gray_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 7}},
+	{ID: 287, Name: "gray_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}}, {{ID: item.Wool, Damage: 7}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 8}},
+	{ID: 288, Name: "granite", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 3}}, {{ID: item.Quartz, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Stone), Count: 1, Damage: 1}},
+	{ID: 289, Name: "golden_sword", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenSword), Count: 1, Damage: 0}},
+	{ID: 290, Name: "golden_shovel", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenShovel), Count: 1, Damage: 0}},
+	{ID: 291, Name: "golden_rail", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenRail), Count: 6, Damage: 0}},
+	{ID: 292, Name: "golden_pickaxe", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.GoldenPickaxe), Count: 1, Damage: 0}},
+	{ID: 293, Name: "golden_leggings", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenLeggings), Count: 1, Damage: 0}},
+	{ID: 294, Name: "golden_hoe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenHoe), Count: 1, Damage: 0}},
+	{ID: 295, Name: "golden_helmet", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenHelmet), Count: 1, Damage: 0}},
+	{ID: 296, Name: "golden_chestplate", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenChestplate), Count: 1, Damage: 0}},
+	{ID: 297, Name: "golden_carrot", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.Carrot, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenCarrot), Count: 1, Damage: 0}},
+	{ID: 298, Name: "golden_boots", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenBoots), Count: 1, Damage: 0}},
+	{ID: 299, Name: "golden_axe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenAxe), Count: 1, Damage: 0}},
+	{ID: 300, Name: "golden_apple", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.Apple, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldenApple), Count: 1, Damage: 0}},
+	{ID: 301, Name: "gold_nugget", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 9, Damage: 0}},
+	{ID: 302, Name: "gold_ingot_from_nuggets", Group: "gold_ingot", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}}, {{ID: item.GoldNugget, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldIngot), Count: 1, Damage: 0}},
+	{ID: 303, Name: "gold_ingot_from_block", Group: "gold_ingot", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.GoldBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldIngot), Count: 9, Damage: 0}},
+	{ID: 304, Name: "gold_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GoldBlock), Count: 1, Damage: 0}},
+	{ID: 305, Name: "glowstone", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.GlowstoneDust, Damage: 0}}, {{ID: item.GlowstoneDust, Damage: 0}}, {{ID: item.GlowstoneDust, Damage: 0}}, {{ID: item.GlowstoneDust, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Glowstone), Count: 1, Damage: 0}},
+	{ID: 306, Name: "glass_pane", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.GlassPane), Count: 16, Damage: 0}},
+	{ID: 307, Name: "glass_bottle", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, nil, {{ID: item.Glass, Damage: 0}}, nil, {{ID: item.Glass, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.GlassBottle), Count: 3, Damage: 0}},
+	{ID: 308, Name: "furnace_minecart", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Furnace, Damage: 0}}, {{ID: item.Minecart, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.FurnaceMinecart), Count: 1, Damage: 0}},
+	{ID: 309, Name: "furnace", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, nil, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Furnace), Count: 1, Damage: 0}},
+	{ID: 310, Name: "flower_pot", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Brick, Damage: 0}}, nil, {{ID: item.Brick, Damage: 0}}, nil, {{ID: item.Brick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.FlowerPot), Count: 1, Damage: 0}},
+	{ID: 311, Name: "flint_and_steel", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.Flint, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.FlintAndSteel), Count: 1, Damage: 0}},
+	{ID: 312, Name: "fishing_rod", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, nil, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, {{ID: item.String, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.String, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.FishingRod), Count: 1, Damage: 0}},
+	{ID: 313, Name: "fire_charge", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Gunpowder, Damage: 0}}, {{ID: item.BlazePowder, Damage: 0}}, {{ID: item.Coal, Damage: 0}, {ID: item.Coal, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.FireCharge), Count: 3, Damage: 0}},
+	{ID: 314, Name: "fermented_spider_eye", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.SpiderEye, Damage: 0}}, {{ID: item.BrownMushroom, Damage: 0}}, {{ID: item.Sugar, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.FermentedSpiderEye), Count: 1, Damage: 0}},
+	{ID: 315, Name: "fence_gate", Group: "wooden_fence_gate", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.FenceGate), Count: 1, Damage: 0}},
+	{ID: 316, Name: "fence", Group: "wooden_fence", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Fence), Count: 3, Damage: 0}},
+	{ID: 317, Name: "ender_eye", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.EnderPearl, Damage: 0}}, {{ID: item.BlazePowder, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.EnderEye), Count: 1, Damage: 0}},
+	{ID: 318, Name: "ender_chest", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.EnderEye, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.EnderChest), Count: 1, Damage: 0}},
+	{ID: 319, Name: "end_rod", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.BlazeRod, Damage: 0}}, {{ID: item.ChorusFruitPopped, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.EndRod), Count: 4, Damage: 0}},
+	{ID: 320, Name: "end_crystal", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.EnderEye, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.GhastTear, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.EndCrystal), Count: 1, Damage: 0}},
+	{ID: 321, Name: "end_bricks", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.EndStone, Damage: 0}}, {{ID: item.EndStone, Damage: 0}}, {{ID: item.EndStone, Damage: 0}}, {{ID: item.EndStone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.EndBricks), Count: 4, Damage: 0}},
+	{ID: 322, Name: "enchanting_table", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.Book, Damage: 0}}, nil, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.EnchantingTable), Count: 1, Damage: 0}},
+	{ID: 323, Name: "emerald_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}}, {{ID: item.Emerald, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.EmeraldBlock), Count: 1, Damage: 0}},
+	{ID: 324, Name: "emerald", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.EmeraldBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Emerald), Count: 9, Damage: 0}},
+	{ID: 325, Name: "dropper", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, nil, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dropper), Count: 1, Damage: 0}},
+	{ID: 326, Name: "dispenser", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Bow, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dispenser), Count: 1, Damage: 0}},
+	{ID: 327, Name: "diorite", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Stone), Count: 2, Damage: 3}},
+	{ID: 328, Name: "diamond_sword", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondSword), Count: 1, Damage: 0}},
+	{ID: 329, Name: "diamond_shovel", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondShovel), Count: 1, Damage: 0}},
+	{ID: 330, Name: "diamond_pickaxe", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.DiamondPickaxe), Count: 1, Damage: 0}},
+	{ID: 331, Name: "diamond_leggings", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Diamond, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondLeggings), Count: 1, Damage: 0}},
+	{ID: 332, Name: "diamond_hoe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondHoe), Count: 1, Damage: 0}},
+	{ID: 333, Name: "diamond_helmet", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Diamond, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondHelmet), Count: 1, Damage: 0}},
+	{ID: 334, Name: "diamond_chestplate", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondChestplate), Count: 1, Damage: 0}},
+	{ID: 335, Name: "diamond_boots", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, nil, {{ID: item.Diamond, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondBoots), Count: 1, Damage: 0}},
+	{ID: 336, Name: "diamond_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondBlock), Count: 1, Damage: 0}},
+	{ID: 337, Name: "diamond_axe", Group: "", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Diamond, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DiamondAxe), Count: 1, Damage: 0}},
+	{ID: 338, Name: "diamond", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.DiamondBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Diamond), Count: 9, Damage: 0}},
+	{ID: 339, Name: "detector_rail", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.StonePressurePlate, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DetectorRail), Count: 6, Damage: 0}},
+	{ID: 340, Name: "daylight_detector", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.WoodenSlab, Damage: 32767}}, {{ID: item.WoodenSlab, Damage: 32767}}, {{ID: item.WoodenSlab, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.DaylightDetector), Count: 1, Damage: 0}},
+	{ID: 341, Name: "dark_prismarine", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.Dye, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}}, {{ID: item.PrismarineShard, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Prismarine), Count: 1, Damage: 2}},
+	{ID: 342, Name: "dark_oak_wooden_slab", Group: "wooden_slab", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.WoodenSlab), Count: 6, Damage: 5}},
+	{ID: 343, Name: "dark_oak_stairs", Group: "wooden_stairs", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 5}}, nil, nil, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, nil, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.DarkOakStairs), Count: 4, Damage: 0}},
+	{ID: 344, Name: "dark_oak_planks", Group: "planks", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Log2, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Planks), Count: 4, Damage: 5}},
+	{ID: 345, Name: "dark_oak_fence_gate", Group: "wooden_fence_gate", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.DarkOakFenceGate), Count: 1, Damage: 0}},
+	{ID: 346, Name: "dark_oak_fence", Group: "wooden_fence", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 5}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.DarkOakFence), Count: 3, Damage: 0}},
+	{ID: 347, Name: "dark_oak_door", Group: "wooden_door", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.DarkOakDoor), Count: 3, Damage: 0}},
+	{ID: 348, Name: "dark_oak_boat", Group: "boat", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 5}}, nil, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}}, {{ID: item.Planks, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.DarkOakBoat), Count: 1, Damage: 0}},
+	{ID: 349, Name: "cyan_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 6}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 9}},
+	{ID: 350, Name: "cyan_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 6}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 9}},
+	{ID: 351, Name: "cyan_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 9}}, {{ID: item.StainedGlass, Damage: 9}}, {{ID: item.StainedGlass, Damage: 9}}, {{ID: item.StainedGlass, Damage: 9}}, {{ID: item.StainedGlass, Damage: 9}}, {{ID: item.StainedGlass, Damage: 9}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 9}},
+	{ID: 352, Name: "cyan_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 6}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 9}},
+	{ID: 353, Name: "cyan_dye", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Dye, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 2, Damage: 6}},
+	{ID: 354, Name: "cyan_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 6}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 9}},
+	{ID: 355, Name: "cyan_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 9}},
+	{ID: 356, Name: "cyan_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 6}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 9}},
+	{ID: 357, Name: "cyan_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 9}},
+	{ID: 358, Name: "cyan_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}}, {{ID: item.Wool, Damage: 9}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 6}},
+	{ID: 359, Name: "crafting_table", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.CraftingTable), Count: 1, Damage: 0}},
+	{ID: 360, Name: "cookie", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wheat, Damage: 0}}, {{ID: item.Dye, Damage: 3}}, {{ID: item.Wheat, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Cookie), Count: 8, Damage: 0}},
+	{ID: 361, Name: "compass", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Compass), Count: 1, Damage: 0}},
+	{ID: 362, Name: "comparator", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.RedstoneTorch, Damage: 0}}, nil, {{ID: item.RedstoneTorch, Damage: 0}}, {{ID: item.Quartz, Damage: 0}}, {{ID: item.RedstoneTorch, Damage: 0}}, {{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}}, {{ID: item.Stone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Comparator), Count: 1, Damage: 0}},
+	{ID: 363, Name: "cobblestone_wall", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.CobblestoneWall), Count: 6, Damage: 0}},
+	{ID: 364, Name: "cobblestone_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, nil, nil, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, nil, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneStairs), Count: 4, Damage: 0}},
+	{ID: 365, Name: "cobblestone_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab), Count: 6, Damage: 3}},
+	{ID: 366, Name: "coarse_dirt", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Dirt, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Dirt, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dirt), Count: 4, Damage: 1}},
+	{ID: 367, Name: "coal_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}}, {{ID: item.Coal, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.CoalBlock), Count: 1, Damage: 0}},
+	{ID: 368, Name: "coal", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.CoalBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Coal), Count: 9, Damage: 0}},
+	{ID: 369, Name: "clock", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}}, {{ID: item.Redstone, Damage: 0}}, {{ID: item.GoldIngot, Damage: 0}}, nil, {{ID: item.GoldIngot, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Clock), Count: 1, Damage: 0}},
+	{ID: 370, Name: "clay", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.ClayBall, Damage: 0}}, {{ID: item.ClayBall, Damage: 0}}, {{ID: item.ClayBall, Damage: 0}}, {{ID: item.ClayBall, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Clay), Count: 1, Damage: 0}},
+	{ID: 371, Name: "chiseled_stonebrick", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StoneSlab, Damage: 5}}, {{ID: item.StoneSlab, Damage: 5}},
+	}, Result: stream.Slot{ID: int16(item.Stonebrick), Count: 1, Damage: 3}},
+	{ID: 372, Name: "chiseled_sandstone", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StoneSlab, Damage: 1}}, {{ID: item.StoneSlab, Damage: 1}},
+	}, Result: stream.Slot{ID: int16(item.Sandstone), Count: 1, Damage: 1}},
+	{ID: 373, Name: "chiseled_red_sandstone", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StoneSlab2, Damage: 0}}, {{ID: item.StoneSlab2, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.RedSandstone), Count: 1, Damage: 1}},
+	{ID: 374, Name: "chiseled_quartz_block", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StoneSlab, Damage: 7}}, {{ID: item.StoneSlab, Damage: 7}},
+	}, Result: stream.Slot{ID: int16(item.QuartzBlock), Count: 1, Damage: 1}},
+	{ID: 375, Name: "chest_minecart", Group: "", Width: 1, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Chest, Damage: 0}}, {{ID: item.Minecart, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ChestMinecart), Count: 1, Damage: 0}},
+	{ID: 376, Name: "chest", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, nil, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Chest), Count: 1, Damage: 0}},
+	{ID: 377, Name: "cauldron", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Cauldron), Count: 1, Damage: 0}},
+	{ID: 378, Name: "carrot_on_a_stick", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.FishingRod, Damage: 0}}, nil, nil, {{ID: item.Carrot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.CarrotOnAStick), Count: 1, Damage: 0}},
+	{ID: 379, Name: "cake", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.MilkBucket, Damage: 0}}, {{ID: item.MilkBucket, Damage: 0}}, {{ID: item.MilkBucket, Damage: 0}}, {{ID: item.Sugar, Damage: 0}}, {{ID: item.Egg, Damage: 0}}, {{ID: item.Sugar, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Cake), Count: 1, Damage: 0}},
+	{ID: 380, Name: "bucket", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Bucket), Count: 1, Damage: 0}},
+	{ID: 381, Name: "brown_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 3}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 12}},
+	{ID: 382, Name: "brown_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 3}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 12}},
+	{ID: 383, Name: "brown_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 12}}, {{ID: item.StainedGlass, Damage: 12}}, {{ID: item.StainedGlass, Damage: 12}}, {{ID: item.StainedGlass, Damage: 12}}, {{ID: item.StainedGlass, Damage: 12}}, {{ID: item.StainedGlass, Damage: 12}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 12}},
+	{ID: 384, Name: "brown_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 3}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 12}},
+	{ID: 385, Name: "brown_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 3}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 12}},
+	{ID: 386, Name: "brown_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 12}},
+	{ID: 387, Name: "brown_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 3}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 12}},
+	{ID: 388, Name: "brown_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 12}},
+	{ID: 389, Name: "brown_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}}, {{ID: item.Wool, Damage: 12}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 3}},
+	{ID: 390, Name: "brick_stairs", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.BrickBlock, Damage: 0}}, nil, nil, {{ID: item.BrickBlock, Damage: 0}}, {{ID: item.BrickBlock, Damage: 0}}, nil, {{ID: item.BrickBlock, Damage: 0}}, {{ID: item.BrickBlock, Damage: 0}}, {{ID: item.BrickBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.BrickStairs), Count: 4, Damage: 0}},
+	{ID: 391, Name: "brick_slab", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.BrickBlock, Damage: 0}}, {{ID: item.BrickBlock, Damage: 0}}, {{ID: item.BrickBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StoneSlab), Count: 6, Damage: 4}},
+	{ID: 392, Name: "brick_block", Group: "", Width: 2, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Brick, Damage: 0}}, {{ID: item.Brick, Damage: 0}}, {{ID: item.Brick, Damage: 0}}, {{ID: item.Brick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.BrickBlock), Count: 1, Damage: 0}},
+	{ID: 393, Name: "brewing_stand", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		nil, {{ID: item.BlazeRod, Damage: 0}}, nil, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.BrewingStand), Count: 1, Damage: 0}},
+	{ID: 394, Name: "bread", Group: "", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}}, {{ID: item.Wheat, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Bread), Count: 1, Damage: 0}},
+	{ID: 395, Name: "bowl", Group: "", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, nil, {{ID: item.Planks, Damage: 32767}}, nil, {{ID: item.Planks, Damage: 32767}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Bowl), Count: 4, Damage: 0}},
+	{ID: 396, Name: "bow", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		nil, {{ID: item.Stick, Damage: 0}}, {{ID: item.String, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.String, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, {{ID: item.String, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Bow), Count: 1, Damage: 0}},
+	{ID: 397, Name: "bookshelf", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Book, Damage: 0}}, {{ID: item.Book, Damage: 0}}, {{ID: item.Book, Damage: 0}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bookshelf), Count: 1, Damage: 0}},
+	{ID: 398, Name: "book", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Paper, Damage: 0}}, {{ID: item.Leather, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Book), Count: 1, Damage: 0}},
+	{ID: 399, Name: "bone_meal_from_bone", Group: "bonemeal", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 3, Damage: 15}},
+	{ID: 400, Name: "bone_meal_from_block", Group: "bonemeal", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.BoneBlock, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Dye), Count: 9, Damage: 15}},
+	{ID: 401, Name: "bone_block", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}}, {{ID: item.Dye, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.BoneBlock), Count: 1, Damage: 0}},
+	{ID: 402, Name: "boat", Group: "boat", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 0}}, nil, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}}, {{ID: item.Planks, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Boat), Count: 1, Damage: 0}},
+	{ID: 403, Name: "blue_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 11}},
+	{ID: 404, Name: "blue_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 11}},
+	{ID: 405, Name: "blue_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 11}}, {{ID: item.StainedGlass, Damage: 11}}, {{ID: item.StainedGlass, Damage: 11}}, {{ID: item.StainedGlass, Damage: 11}}, {{ID: item.StainedGlass, Damage: 11}}, {{ID: item.StainedGlass, Damage: 11}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 11}},
+	{ID: 406, Name: "blue_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 4}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 11}},
+	{ID: 407, Name: "blue_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 4}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 11}},
+	{ID: 408, Name: "blue_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 11}},
+	{ID: 409, Name: "blue_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 11}},
+	{ID: 410, Name: "blue_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 11}},
+	{ID: 411, Name: "blue_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}}, {{ID: item.Wool, Damage: 11}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 4}},
+	{ID: 412, Name: "blaze_powder", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.BlazeRod, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.BlazePowder), Count: 2, Damage: 0}},
+	{ID: 413, Name: "black_wool", Group: "wool", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 0}}, {{ID: item.Wool, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Wool), Count: 1, Damage: 15}},
+	{ID: 414, Name: "black_stained_hardened_clay", Group: "stained_hardened_clay", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.Dye, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}}, {{ID: item.HardenedClay, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedHardenedClay), Count: 8, Damage: 15}},
+	{ID: 415, Name: "black_stained_glass_pane", Group: "stained_glass_pane", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.StainedGlass, Damage: 15}}, {{ID: item.StainedGlass, Damage: 15}}, {{ID: item.StainedGlass, Damage: 15}}, {{ID: item.StainedGlass, Damage: 15}}, {{ID: item.StainedGlass, Damage: 15}}, {{ID: item.StainedGlass, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlassPane), Count: 16, Damage: 15}},
+	{ID: 416, Name: "black_stained_glass", Group: "stained_glass", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Dye, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.StainedGlass), Count: 8, Damage: 15}},
+	{ID: 417, Name: "black_concrete_powder", Group: "concrete_powder", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Dye, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Sand, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}}, {{ID: item.Gravel, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ConcretePowder), Count: 8, Damage: 15}},
+	{ID: 418, Name: "black_carpet", Group: "carpet", Width: 2, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}},
+	}, Result: stream.Slot{ID: int16(item.Carpet), Count: 3, Damage: 15}},
+	{ID: 419, Name: "black_bed_from_white_bed", Group: "dyed_bed", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Bed, Damage: 0}}, {{ID: item.Dye, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 15}},
+	{ID: 420, Name: "black_bed", Group: "bed", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}}, {{ID: item.Planks, Damage: 32767}},
+	}, Result: stream.Slot{ID: int16(item.Bed), Count: 1, Damage: 15}},
+	{ID: 421, Name: "black_banner", Group: "banner", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}}, {{ID: item.Wool, Damage: 15}}, nil, {{ID: item.Stick, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.Banner), Count: 1, Damage: 0}},
+	{ID: 422, Name: "birch_wooden_slab", Group: "wooden_slab", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.WoodenSlab), Count: 6, Damage: 2}},
+	{ID: 423, Name: "birch_stairs", Group: "wooden_stairs", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 2}}, nil, nil, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, nil, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.BirchStairs), Count: 4, Damage: 0}},
+	{ID: 424, Name: "birch_planks", Group: "planks", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Log, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.Planks), Count: 4, Damage: 2}},
+	{ID: 425, Name: "birch_fence_gate", Group: "wooden_fence_gate", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.BirchFenceGate), Count: 1, Damage: 0}},
+	{ID: 426, Name: "birch_fence", Group: "wooden_fence", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 2}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.BirchFence), Count: 3, Damage: 0}},
+	{ID: 427, Name: "birch_door", Group: "wooden_door", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.BirchDoor), Count: 3, Damage: 0}},
+	{ID: 428, Name: "birch_boat", Group: "boat", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 2}}, nil, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}}, {{ID: item.Planks, Damage: 2}},
+	}, Result: stream.Slot{ID: int16(item.BirchBoat), Count: 1, Damage: 0}},
+	{ID: 429, Name: "beetroot_soup", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Beetroot, Damage: 0}}, {{ID: item.Beetroot, Damage: 0}}, {{ID: item.Beetroot, Damage: 0}}, {{ID: item.Beetroot, Damage: 0}}, {{ID: item.Beetroot, Damage: 0}}, {{ID: item.Beetroot, Damage: 0}}, nil, {{ID: item.Bowl, Damage: 0}}, nil,
+	}, Result: stream.Slot{ID: int16(item.BeetrootSoup), Count: 1, Damage: 0}},
+	{ID: 430, Name: "beacon", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.NetherStar, Damage: 0}}, {{ID: item.Glass, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}}, {{ID: item.Obsidian, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Beacon), Count: 1, Damage: 0}},
+	{ID: 431, Name: "arrow", Group: "", Width: 1, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Flint, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Feather, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Arrow), Count: 4, Damage: 0}},
+	{ID: 432, Name: "armor_stand", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, nil, {{ID: item.Stick, Damage: 0}}, {{ID: item.StoneSlab, Damage: 0}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ArmorStand), Count: 1, Damage: 0}},
+	{ID: 433, Name: "anvil", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronBlock, Damage: 0}}, {{ID: item.IronBlock, Damage: 0}}, {{ID: item.IronBlock, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, nil, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Anvil), Count: 1, Damage: 0}},
+	{ID: 434, Name: "andesite", Group: "", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Stone, Damage: 3}}, {{ID: item.Cobblestone, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Stone), Count: 2, Damage: 5}},
+	{ID: 435, Name: "activator_rail", Group: "", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.IronIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.RedstoneTorch, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.IronIngot, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.ActivatorRail), Count: 6, Damage: 0}},
+	{ID: 436, Name: "acacia_wooden_slab", Group: "wooden_slab", Width: 3, Height: 1, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.WoodenSlab), Count: 6, Damage: 4}},
+	{ID: 437, Name: "acacia_stairs", Group: "wooden_stairs", Width: 3, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 4}}, nil, nil, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, nil, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.AcaciaStairs), Count: 4, Damage: 0}},
+	{ID: 438, Name: "acacia_planks", Group: "planks", Shapeless: true, Ingredients: []Ingredient{
+		{{ID: item.Log2, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.Planks), Count: 4, Damage: 4}},
+	{ID: 439, Name: "acacia_fence_gate", Group: "wooden_fence_gate", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Stick, Damage: 0}},
+	}, Result: stream.Slot{ID: int16(item.AcaciaFenceGate), Count: 1, Damage: 0}},
+	{ID: 440, Name: "acacia_fence", Group: "wooden_fence", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 4}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Stick, Damage: 0}}, {{ID: item.Planks, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.AcaciaFence), Count: 3, Damage: 0}},
+	{ID: 441, Name: "acacia_door", Group: "wooden_door", Width: 2, Height: 3, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.AcaciaDoor), Count: 3, Damage: 0}},
+	{ID: 442, Name: "acacia_boat", Group: "boat", Width: 3, Height: 2, Ingredients: []Ingredient{
+		{{ID: item.Planks, Damage: 4}}, nil, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}}, {{ID: item.Planks, Damage: 4}},
+	}, Result: stream.Slot{ID: int16(item.AcaciaBoat), Count: 1, Damage: 0}},
+}
+
+var smelting = []Smelting{
+	{Input: Choice{ID: item.IronOre, Damage: 0}, Result: stream.Slot{ID: int16(item.IronIngot), Count: 1, Damage: 0}, Experience: 0.7},
+	{Input: Choice{ID: item.GoldOre, Damage: 0}, Result: stream.Slot{ID: int16(item.GoldIngot), Count: 1, Damage: 0}, Experience: 1},
+	{Input: Choice{ID: item.DiamondOre, Damage: 0}, Result: stream.Slot{ID: int16(item.Diamond), Count: 1, Damage: 0}, Experience: 1},
+	{Input: Choice{ID: item.Sand, Damage: 32767}, Result: stream.Slot{ID: int16(item.Glass), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.Porkchop, Damage: 0}, Result: stream.Slot{ID: int16(item.CookedPorkchop), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Beef, Damage: 0}, Result: stream.Slot{ID: int16(item.CookedBeef), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Chicken, Damage: 0}, Result: stream.Slot{ID: int16(item.CookedChicken), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Rabbit, Damage: 0}, Result: stream.Slot{ID: int16(item.CookedRabbit), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Mutton, Damage: 0}, Result: stream.Slot{ID: int16(item.CookedMutton), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Cobblestone, Damage: 0}, Result: stream.Slot{ID: int16(item.Stone), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.Stonebrick, Damage: 0}, Result: stream.Slot{ID: int16(item.Stonebrick), Count: 1, Damage: 2}, Experience: 0.1},
+	{Input: Choice{ID: item.ClayBall, Damage: 0}, Result: stream.Slot{ID: int16(item.Brick), Count: 1, Damage: 0}, Experience: 0.3},
+	{Input: Choice{ID: item.Clay, Damage: 0}, Result: stream.Slot{ID: int16(item.HardenedClay), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Cactus, Damage: 0}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 2}, Experience: 0.2},
+	{Input: Choice{ID: item.Log, Damage: 32767}, Result: stream.Slot{ID: int16(item.Coal), Count: 1, Damage: 1}, Experience: 0.15},
+	{Input: Choice{ID: item.Log2, Damage: 32767}, Result: stream.Slot{ID: int16(item.Coal), Count: 1, Damage: 1}, Experience: 0.15},
+	{Input: Choice{ID: item.EmeraldOre, Damage: 0}, Result: stream.Slot{ID: int16(item.Emerald), Count: 1, Damage: 0}, Experience: 1},
+	{Input: Choice{ID: item.Potato, Damage: 0}, Result: stream.Slot{ID: int16(item.BakedPotato), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Netherrack, Damage: 0}, Result: stream.Slot{ID: int16(item.Netherbrick), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.Sponge, Damage: 1}, Result: stream.Slot{ID: int16(item.Sponge), Count: 1, Damage: 0}, Experience: 0.15},
+	{Input: Choice{ID: item.ChorusFruit, Damage: 0}, Result: stream.Slot{ID: int16(item.ChorusFruitPopped), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.Fish, Damage: 0}, Result: stream.Slot{ID: int16(item.CookedFish), Count: 1, Damage: 0}, Experience: 0.35},
+	{Input: Choice{ID: item.Fish, Damage: 1}, Result: stream.Slot{ID: int16(item.CookedFish), Count: 1, Damage: 1}, Experience: 0.35},
+	{Input: Choice{ID: item.CoalOre, Damage: 0}, Result: stream.Slot{ID: int16(item.Coal), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.RedstoneOre, Damage: 0}, Result: stream.Slot{ID: int16(item.Redstone), Count: 1, Damage: 0}, Experience: 0.7},
+	{Input: Choice{ID: item.LapisOre, Damage: 0}, Result: stream.Slot{ID: int16(item.Dye), Count: 1, Damage: 4}, Experience: 0.2},
+	{Input: Choice{ID: item.QuartzOre, Damage: 0}, Result: stream.Slot{ID: int16(item.Quartz), Count: 1, Damage: 0}, Experience: 0.2},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 0}, Result: stream.Slot{ID: int16(item.WhiteGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 1}, Result: stream.Slot{ID: int16(item.OrangeGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 2}, Result: stream.Slot{ID: int16(item.MagentaGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 3}, Result: stream.Slot{ID: int16(item.LightBlueGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 4}, Result: stream.Slot{ID: int16(item.YellowGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 5}, Result: stream.Slot{ID: int16(item.LimeGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 6}, Result: stream.Slot{ID: int16(item.PinkGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 7}, Result: stream.Slot{ID: int16(item.GrayGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 8}, Result: stream.Slot{ID: int16(item.SilverGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 9}, Result: stream.Slot{ID: int16(item.CyanGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 10}, Result: stream.Slot{ID: int16(item.PurpleGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 11}, Result: stream.Slot{ID: int16(item.BlueGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 12}, Result: stream.Slot{ID: int16(item.BrownGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 13}, Result: stream.Slot{ID: int16(item.GreenGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 14}, Result: stream.Slot{ID: int16(item.RedGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.StainedHardenedClay, Damage: 15}, Result: stream.Slot{ID: int16(item.BlackGlazedTerracotta), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronPickaxe, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronShovel, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronAxe, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronHoe, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronSword, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronHelmet, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronChestplate, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronLeggings, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronBoots, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.IronHorseArmor, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.ChainmailHelmet, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.ChainmailChestplate, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.ChainmailLeggings, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.ChainmailBoots, Damage: 32767}, Result: stream.Slot{ID: int16(item.IronNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenPickaxe, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenShovel, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenAxe, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenHoe, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenSword, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenHelmet, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenChestplate, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenLeggings, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenBoots, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+	{Input: Choice{ID: item.GoldenHorseArmor, Damage: 32767}, Result: stream.Slot{ID: int16(item.GoldNugget), Count: 1, Damage: 0}, Experience: 0.1},
+}
+
+// burn ticks of fuels
+var fuels = map[uint16]int{
+	item.LavaBucket:          20000,
+	item.CoalBlock:           16000,
+	item.BlazeRod:            2400,
+	item.Coal:                1600,
+	item.Boat:                400,
+	item.SpruceBoat:          400,
+	item.BirchBoat:           400,
+	item.JungleBoat:          400,
+	item.AcaciaBoat:          400,
+	item.DarkOakBoat:         400,
+	item.Planks:              300,
+	item.Log:                 300,
+	item.Log2:                300,
+	item.CraftingTable:       300,
+	item.Chest:               300,
+	item.TrappedChest:        300,
+	item.Bookshelf:           300,
+	item.Jukebox:             300,
+	item.Noteblock:           300,
+	item.Fence:               300,
+	item.FenceGate:           300,
+	item.Trapdoor:            300,
+	item.WoodenPressurePlate: 300,
+	item.OakStairs:           300,
+	item.Ladder:              300,
+	item.Bow:                 300,
+	item.FishingRod:          300,
+	item.DaylightDetector:    300,
+	item.WoodenPickaxe:       200,
+	item.WoodenAxe:           200,
+	item.WoodenShovel:        200,
+	item.WoodenHoe:           200,
+	item.WoodenSword:         200,
+	item.WoodenDoor:          200,
+	item.Sign:                200,
+	item.WoodenSlab:          150,
+	item.Stick:               100,
+	item.Sapling:             100,
+	item.Bowl:                100,
+	item.WoodenButton:        100,
+	item.Wool:                100,
+	item.Carpet:              67,
+}
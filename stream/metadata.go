@@ -0,0 +1,269 @@
+package stream
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/seebs/nbt"
+)
+
+//go:generate stringer -type=MetadataType -linecomment -output=metadata_type_stringer.go
+type MetadataType uint64
+
+const (
+	MetadataByte        MetadataType = iota // byte
+	MetadataVarInt                          // varint
+	MetadataFloat                           // float
+	MetadataString                          // string
+	MetadataChat                            // chat
+	MetadataSlot                            // slot
+	MetadataBoolean                         // boolean
+	MetadataRotation                        // rotation
+	MetadataPosition                        // position
+	MetadataOptPosition                     // opt position
+	MetadataDirection                       // direction
+	MetadataOptUUID                         // opt uuid
+	MetadataOptBlockID                      // opt block id
+	MetadataNBT                             // nbt
+)
+
+// metadataEnd is the index ends the metadata array
+const metadataEnd = 0xff
+
+type metadataEntry struct {
+	typ   MetadataType
+	value interface{}
+}
+
+// Metadata is entity metadata of 1.12, it records which entries changed since last flush,
+// so only these entries need to be sent in Entity Metadata.
+type Metadata struct {
+	mu      sync.RWMutex
+	entries map[uint8]metadataEntry
+	dirty   map[uint8]struct{}
+}
+
+func NewMetadata() *Metadata {
+	return &Metadata{
+		entries: make(map[uint8]metadataEntry),
+		dirty:   make(map[uint8]struct{}),
+	}
+}
+
+func (m *Metadata) set(index uint8, typ MetadataType, value interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if old, ok := m.entries[index]; ok && old.typ == typ && reflect.DeepEqual(old.value, value) {
+		return
+	}
+	m.entries[index] = metadataEntry{typ: typ, value: value}
+	m.dirty[index] = struct{}{}
+}
+
+// Get return type and value at index.
+func (m *Metadata) Get(index uint8) (MetadataType, interface{}, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entry, ok := m.entries[index]
+	return entry.typ, entry.value, ok
+}
+
+func (m *Metadata) SetByte(index uint8, v byte) { m.set(index, MetadataByte, v) }
+
+func (m *Metadata) SetVarInt(index uint8, v int32) { m.set(index, MetadataVarInt, v) }
+
+func (m *Metadata) SetFloat(index uint8, v float32) { m.set(index, MetadataFloat, v) }
+
+func (m *Metadata) SetString(index uint8, v string) { m.set(index, MetadataString, v) }
+
+// SetChat set a chat component in json.
+func (m *Metadata) SetChat(index uint8, v string) { m.set(index, MetadataChat, v) }
+
+func (m *Metadata) SetSlot(index uint8, v Slot) { m.set(index, MetadataSlot, v) }
+
+func (m *Metadata) SetBoolean(index uint8, v bool) { m.set(index, MetadataBoolean, v) }
+
+func (m *Metadata) SetRotation(index uint8, v Rotation) { m.set(index, MetadataRotation, v) }
+
+func (m *Metadata) SetPosition(index uint8, v Position) { m.set(index, MetadataPosition, v) }
+
+// SetOptPosition set an optional position, nil means absent.
+func (m *Metadata) SetOptPosition(index uint8, v *Position) {
+	if v != nil {
+		p := *v
+		v = &p
+	}
+	m.set(index, MetadataOptPosition, v)
+}
+
+// SetDirection set direction, down=0, up=1, north=2, south=3, west=4, east=5.
+func (m *Metadata) SetDirection(index uint8, v int32) { m.set(index, MetadataDirection, v) }
+
+// SetOptUUID set an optional uuid, nil means absent.
+func (m *Metadata) SetOptUUID(index uint8, v *uuid.UUID) {
+	if v != nil {
+		id := *v
+		v = &id
+	}
+	m.set(index, MetadataOptUUID, v)
+}
+
+// SetOptBlockID set block state id as id<<4|meta, 0 means absent.
+func (m *Metadata) SetOptBlockID(index uint8, v int32) { m.set(index, MetadataOptBlockID, v) }
+
+func (m *Metadata) SetNBT(index uint8, v nbt.Compound) { m.set(index, MetadataNBT, v) }
+
+// Byte return byte at index, 0 if not set.
+func (m *Metadata) Byte(index uint8) byte {
+	_, v, _ := m.Get(index)
+	b, _ := v.(byte)
+	return b
+}
+
+// Dirty report whether any entry changed since last flush.
+func (m *Metadata) Dirty() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.dirty) > 0
+}
+
+func writeMetadataEntry(w *Writer, index uint8, entry metadataEntry) {
+	w.WriteUByte(index).WriteVarInt(uint64(entry.typ))
+	switch v := entry.value.(type) {
+	case byte:
+		w.WriteUByte(v)
+	case int32:
+		// VarInt, Direction and OptBlockID
+		w.WriteVarInt(uint64(uint32(v)))
+	case float32:
+		w.WriteFloat(v)
+	case string:
+		// String and Chat
+		w.WriteString(v)
+	case Slot:
+		w.WriteSlot(v)
+	case bool:
+		w.WriteBoolean(v)
+	case Rotation:
+		w.WriteFloat(v.X).WriteFloat(v.Y).WriteFloat(v.Z)
+	case Position:
+		w.WritePosition(v)
+	case *Position:
+		w.WriteBoolean(v != nil)
+		if v != nil {
+			w.WritePosition(*v)
+		}
+	case *uuid.UUID:
+		w.WriteBoolean(v != nil)
+		if v != nil {
+			w.WriteUUID(*v)
+		}
+	case nbt.Compound:
+		w.WriteNbt(v)
+	default:
+		w.Error = fmt.Errorf("unsupported metadata value %T", v)
+	}
+}
+
+func (m *Metadata) write(w *Writer, onlyDirty bool) *Writer {
+	indexes := make([]int, 0, len(m.entries))
+	for index := range m.entries {
+		if _, dirty := m.dirty[index]; dirty || !onlyDirty {
+			indexes = append(indexes, int(index))
+		}
+	}
+	sort.Ints(indexes)
+
+	for _, index := range indexes {
+		writeMetadataEntry(w, uint8(index), m.entries[uint8(index)])
+	}
+	return w.WriteUByte(metadataEnd)
+}
+
+// WriteMetadata write all entries of m, used when spawning entities.
+func (w *Writer) WriteMetadata(m *Metadata) *Writer {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.write(w, false)
+}
+
+// WriteDirtyMetadata write entries changed since last flush and clear the dirty marks.
+func (w *Writer) WriteDirtyMetadata(m *Metadata) *Writer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.write(w, true)
+	m.dirty = make(map[uint8]struct{})
+	return w
+}
+
+func (r *Reader) ReadMetadata() (*Metadata, error) {
+	m := NewMetadata()
+	for {
+		index, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if index == metadataEnd {
+			return m, nil
+		}
+
+		typ, err := r.ReadVarInt()
+		if err != nil {
+			return nil, err
+		}
+
+		var value interface{}
+		switch MetadataType(typ) {
+		case MetadataByte:
+			value, err = r.ReadByte()
+		case MetadataVarInt, MetadataDirection, MetadataOptBlockID:
+			var v uint64
+			v, err = r.ReadVarInt()
+			value = int32(v)
+		case MetadataFloat:
+			value, err = r.ReadFloat()
+		case MetadataString, MetadataChat:
+			value, err = r.ReadString()
+		case MetadataSlot:
+			value, err = r.ReadSlot()
+		case MetadataBoolean:
+			value, err = r.ReadBoolean()
+		case MetadataRotation:
+			var rot Rotation
+			rot.X, _ = r.ReadFloat()
+			rot.Y, _ = r.ReadFloat()
+			rot.Z, err = r.ReadFloat()
+			value = rot
+		case MetadataPosition:
+			value, err = r.ReadPosition()
+		case MetadataOptPosition:
+			var p *Position
+			if present, _ := r.ReadBoolean(); present {
+				var v Position
+				v, err = r.ReadPosition()
+				p = &v
+			}
+			value = p
+		case MetadataOptUUID:
+			var id *uuid.UUID
+			if present, _ := r.ReadBoolean(); present {
+				var v uuid.UUID
+				v, err = r.ReadUUID()
+				id = &v
+			}
+			value = id
+		case MetadataNBT:
+			value, err = r.ReadNbt()
+		default:
+			return nil, fmt.Errorf("unknown metadata type %d at index %d", typ, index)
+		}
+		if err != nil {
+			return nil, err
+		}
+		m.set(index, MetadataType(typ), value)
+	}
+}
@@ -0,0 +1,126 @@
+package stream
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/seebs/nbt"
+)
+
+func TestPositionRoundTrip(t *testing.T) {
+	for _, p := range []Position{
+		{0, 0, 0},
+		{1, 64, -1},
+		{-1, 255, -1},
+		{18357644, 831, -20882616},
+		// the most of each field
+		{1<<25 - 1, 1<<11 - 1, 1<<25 - 1},
+		{-1 << 25, -1 << 11, -1 << 25},
+	} {
+		if got := DecodePosition(p.Encode()); got != p {
+			t.Errorf("position %v decoded as %v", p, got)
+		}
+
+		var buf bytes.Buffer
+		if w := NewWriter(&buf).WritePosition(p); w.Error != nil {
+			t.Fatal(w.Error)
+		}
+		got, err := NewReader(&buf).ReadPosition()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != p {
+			t.Errorf("position %v read as %v", p, got)
+		}
+	}
+}
+
+func TestMetadataRoundTrip(t *testing.T) {
+	id := uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5")
+	pos := Position{X: -7, Y: 70, Z: 300}
+	for _, c := range []struct {
+		typ   MetadataType
+		set   func(m *Metadata)
+		value interface{}
+	}{
+		{MetadataByte, func(m *Metadata) { m.SetByte(0, 0x22) }, byte(0x22)},
+		{MetadataVarInt, func(m *Metadata) { m.SetVarInt(0, 300) }, int32(300)},
+		{MetadataVarInt, func(m *Metadata) { m.SetVarInt(0, -1) }, int32(-1)},
+		{MetadataFloat, func(m *Metadata) { m.SetFloat(0, 20) }, float32(20)},
+		{MetadataString, func(m *Metadata) { m.SetString(0, "Notch") }, "Notch"},
+		{MetadataChat, func(m *Metadata) { m.SetChat(0, `{"text":"hi"}`) }, `{"text":"hi"}`},
+		{MetadataSlot, func(m *Metadata) { m.SetSlot(0, Slot{ID: 1, Count: 64, Damage: 3}) }, Slot{ID: 1, Count: 64, Damage: 3}},
+		{MetadataSlot, func(m *Metadata) { m.SetSlot(0, EmptySlot) }, EmptySlot},
+		{MetadataBoolean, func(m *Metadata) { m.SetBoolean(0, true) }, true},
+		{MetadataRotation, func(m *Metadata) { m.SetRotation(0, Rotation{1, -2, 3.5}) }, Rotation{1, -2, 3.5}},
+		{MetadataPosition, func(m *Metadata) { m.SetPosition(0, pos) }, pos},
+		{MetadataOptPosition, func(m *Metadata) { m.SetOptPosition(0, &pos) }, &pos},
+		{MetadataOptPosition, func(m *Metadata) { m.SetOptPosition(0, nil) }, (*Position)(nil)},
+		{MetadataDirection, func(m *Metadata) { m.SetDirection(0, 5) }, int32(5)},
+		{MetadataOptUUID, func(m *Metadata) { m.SetOptUUID(0, &id) }, &id},
+		{MetadataOptUUID, func(m *Metadata) { m.SetOptUUID(0, nil) }, (*uuid.UUID)(nil)},
+		{MetadataOptBlockID, func(m *Metadata) { m.SetOptBlockID(0, 1<<4|2) }, int32(1<<4 | 2)},
+		{MetadataNBT, func(m *Metadata) { m.SetNBT(0, nbt.Compound{"id": nbt.String("minecraft:stone")}) },
+			nbt.Compound{"id": nbt.String("minecraft:stone")}},
+	} {
+		m := NewMetadata()
+		c.set(m)
+		// entries before and after are not mixed with the one tested
+		m.SetByte(1, 7)
+		m.SetFloat(7, 1.5)
+
+		var buf bytes.Buffer
+		if w := NewWriter(&buf).WriteMetadata(m); w.Error != nil {
+			t.Fatalf("%s: %v", c.typ, w.Error)
+		}
+		got, err := NewReader(&buf).ReadMetadata()
+		if err != nil {
+			t.Fatalf("%s: %v", c.typ, err)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("%s: %d bytes left after metadata", c.typ, buf.Len())
+		}
+		typ, value, ok := got.Get(0)
+		if !ok || typ != c.typ || !reflect.DeepEqual(value, c.value) {
+			t.Errorf("%s: read %s %#v, want %#v", c.typ, typ, value, c.value)
+		}
+		if got.Byte(1) != 7 {
+			t.Errorf("%s: byte at 1 read as %d", c.typ, got.Byte(1))
+		}
+		if _, v, _ := got.Get(7); v != float32(1.5) {
+			t.Errorf("%s: float at 7 read as %v", c.typ, v)
+		}
+	}
+}
+
+func TestDirtyMetadata(t *testing.T) {
+	m := NewMetadata()
+	m.SetByte(0, 1)
+	m.SetFloat(7, 20)
+	var buf bytes.Buffer
+	NewWriter(&buf).WriteDirtyMetadata(m)
+	if m.Dirty() {
+		t.Fatal("metadata is dirty after written")
+	}
+
+	// setting the same value is not a change
+	m.SetFloat(7, 20)
+	m.SetByte(0, 2)
+	if !m.Dirty() {
+		t.Fatal("metadata is not dirty after changed")
+	}
+	buf.Reset()
+	NewWriter(&buf).WriteDirtyMetadata(m)
+	got, err := NewReader(&buf).ReadMetadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := got.Get(7); ok {
+		t.Error("entry not changed is written")
+	}
+	if got.Byte(0) != 2 {
+		t.Errorf("changed byte read as %d", got.Byte(0))
+	}
+}
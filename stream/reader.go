@@ -3,8 +3,12 @@ package stream
 import (
 	"bufio"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"math"
+
+	"github.com/google/uuid"
+	"github.com/seebs/nbt"
 )
 
 type Reader struct {
@@ -101,3 +105,58 @@ func (r *Reader) ReadFloat() (float32, error) {
 	}
 	return math.Float32frombits(a), nil
 }
+
+func (r *Reader) ReadUUID() (uuid.UUID, error) {
+	var id uuid.UUID
+	raw, err := r.ReadRaw(len(id))
+	if err != nil {
+		return id, err
+	}
+	copy(id[:], raw)
+	return id, nil
+}
+
+func (r *Reader) ReadPosition() (Position, error) {
+	v, err := r.ReadLong()
+	if err != nil {
+		return Position{}, err
+	}
+	return DecodePosition(v), nil
+}
+
+// ReadNbt read a compound, nil if TAG_End.
+func (r *Reader) ReadNbt() (nbt.Compound, error) {
+	tag, _, err := nbt.LoadUncompressed(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if tag.Type() == nbt.TypeEnd {
+		return nil, nil
+	}
+	compound, ok := nbt.GetCompound(tag)
+	if !ok {
+		return nil, fmt.Errorf("expect compound tag but got %s", tag.Type())
+	}
+	return compound, nil
+}
+
+func (r *Reader) ReadSlot() (Slot, error) {
+	id, err := r.ReadShort()
+	if err != nil {
+		return EmptySlot, err
+	}
+	slot := Slot{ID: int16(id)}
+	if slot.ID < 0 {
+		return EmptySlot, nil
+	}
+	if slot.Count, err = r.ReadByte(); err != nil {
+		return EmptySlot, err
+	}
+	damage, err := r.ReadShort()
+	if err != nil {
+		return EmptySlot, err
+	}
+	slot.Damage = int16(damage)
+	slot.NBT, err = r.ReadNbt()
+	return slot, err
+}
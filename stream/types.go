@@ -0,0 +1,50 @@
+package stream
+
+import "github.com/seebs/nbt"
+
+// Position of a block, encoded as x (26 bits), y (12 bits), z (26 bits) in a long.
+type Position struct {
+	X, Y, Z int32
+}
+
+func (p Position) Encode() uint64 {
+	return (uint64(p.X)&0x3FFFFFF)<<38 | (uint64(p.Y)&0xFFF)<<26 | uint64(p.Z)&0x3FFFFFF
+}
+
+func DecodePosition(v uint64) Position {
+	p := Position{
+		X: int32(v >> 38),
+		Y: int32(v>>26) & 0xFFF,
+		Z: int32(v << 38 >> 38),
+	}
+	// sign extend
+	if p.X >= 1<<25 {
+		p.X -= 1 << 26
+	}
+	if p.Y >= 1<<11 {
+		p.Y -= 1 << 12
+	}
+	if p.Z >= 1<<25 {
+		p.Z -= 1 << 26
+	}
+	return p
+}
+
+// Slot is an item stack in inventory, ID -1 means empty.
+type Slot struct {
+	ID     int16
+	Count  byte
+	Damage int16
+	NBT    nbt.Compound
+}
+
+var EmptySlot = Slot{ID: -1}
+
+func (s Slot) Empty() bool {
+	return s.ID < 0 || s.Count == 0
+}
+
+// Rotation of armor stand's parts in degree.
+type Rotation struct {
+	X, Y, Z float32
+}
@@ -2,9 +2,11 @@ package stream
 
 import (
 	"encoding/binary"
-	"github.com/seebs/nbt"
 	"io"
 	"math"
+
+	"github.com/google/uuid"
+	"github.com/seebs/nbt"
 )
 
 type Writer struct {
@@ -26,6 +28,18 @@ func (w *Writer) WriteRaw(a []byte) *Writer {
 	return w
 }
 
+func (w *Writer) WriteUByte(a byte) *Writer {
+	w.buf[0] = a
+	return w.WriteRaw(w.buf[:1])
+}
+
+func (w *Writer) WriteBoolean(a bool) *Writer {
+	if a {
+		return w.WriteUByte(1)
+	}
+	return w.WriteUByte(0)
+}
+
 func (w *Writer) WriteVarInt(a uint64) *Writer {
 	n := binary.PutUvarint(w.buf[:], a)
 	return w.WriteRaw(w.buf[:n])
@@ -58,9 +72,29 @@ func (w *Writer) WriteString(a string) *Writer {
 	return w.WriteVarInt(uint64(len(a))).WriteRaw([]byte(a))
 }
 
+func (w *Writer) WriteUUID(a uuid.UUID) *Writer {
+	return w.WriteRaw(a[:])
+}
+
 func (w *Writer) WriteNbt(tag nbt.Compound) *Writer {
 	if err := nbt.StoreUncompressed(w.w, tag, ""); err != nil {
 		w.Error = err
 	}
 	return w
 }
+
+func (w *Writer) WritePosition(a Position) *Writer {
+	return w.WriteLong(a.Encode())
+}
+
+func (w *Writer) WriteSlot(a Slot) *Writer {
+	if a.Empty() {
+		return w.WriteShort(uint16(EmptySlot.ID))
+	}
+	w.WriteShort(uint16(a.ID)).WriteUByte(a.Count).WriteShort(uint16(a.Damage))
+	if a.NBT == nil {
+		// TAG_End means no nbt
+		return w.WriteUByte(0)
+	}
+	return w.WriteNbt(a.NBT)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/laushunyu/real/constants"
+	"github.com/laushunyu/real/packet"
+)
+
+// tab list placeholders, replaced when header and footer are sent
+const (
+	PlaceholderPlayer = "{player}"
+	PlaceholderOnline = "{online}"
+	PlaceholderMax    = "{max}"
+	PlaceholderPing   = "{ping}"
+)
+
+type PlayerProperty struct {
+	Name      string
+	Value     string
+	Signature string // empty if not signed
+}
+
+// NewPlayerListItemPacket build Player List Item packet of action for players.
+func NewPlayerListItemPacket(action constants.PlayerListAction, players ...*Player) packet.Packet {
+	pkt := packet.NewPacket(0x2E)
+	pkt.WriteVarInt(uint64(action)).WriteVarInt(uint64(len(players)))
+	for _, p := range players {
+		pkt.WriteUUID(p.Meta.UserID)
+		switch action {
+		case constants.PlayerListAddPlayer:
+			pkt.WriteString(p.Meta.User).WriteVarInt(uint64(len(p.Meta.Properties)))
+			for _, property := range p.Meta.Properties {
+				pkt.WriteString(property.Name).WriteString(property.Value)
+				pkt.WriteBoolean(property.Signature != "")
+				if property.Signature != "" {
+					pkt.WriteString(property.Signature)
+				}
+			}
+			pkt.WriteVarInt(uint64(p.GameMode)).WriteVarInt(uint64(p.Ping))
+			writeDisplayName(pkt, p.DisplayName)
+		case constants.PlayerListUpdateGameMode:
+			pkt.WriteVarInt(uint64(p.GameMode))
+		case constants.PlayerListUpdateLatency:
+			pkt.WriteVarInt(uint64(p.Ping))
+		case constants.PlayerListUpdateDisplayName:
+			writeDisplayName(pkt, p.DisplayName)
+		case constants.PlayerListRemovePlayer:
+			// only uuid
+		}
+	}
+	return pkt
+}
+
+func writeDisplayName(pkt packet.Packet, name *Chat) {
+	pkt.WriteBoolean(name != nil)
+	if name != nil {
+		pkt.WriteString(name.String())
+	}
+}
+
+// SetDisplayName change the name shown in tab list, nil to use the username.
+func (player *Player) SetDisplayName(name *Chat) {
+	player.DisplayName = name
+	player.server.Broadcast(NewPlayerListItemPacket(constants.PlayerListUpdateDisplayName, player))
+}
+
+// SetPing records the latency in milliseconds and tells every player.
+func (player *Player) SetPing(ping int64) {
+	player.Ping = ping
+	player.server.Broadcast(NewPlayerListItemPacket(constants.PlayerListUpdateLatency, player))
+	player.SendTabListHeaderFooter()
+}
+
+// SetTabListHeaderFooter set header and footer of player's tab list, nil to use the server default.
+// Placeholders in text will be replaced before send.
+func (player *Player) SetTabListHeaderFooter(header, footer *Chat) {
+	player.TabHeader = header
+	player.TabFooter = footer
+	player.SendTabListHeaderFooter()
+}
+
+// SendTabListHeaderFooter send Player List Header And Footer with placeholders replaced.
+func (player *Player) SendTabListHeaderFooter() {
+	header, footer := player.server.TabHeader, player.server.TabFooter
+	if player.TabHeader != nil {
+		header = *player.TabHeader
+	}
+	if player.TabFooter != nil {
+		footer = *player.TabFooter
+	}
+
+	r := strings.NewReplacer(
+		PlaceholderPlayer, player.Meta.User,
+		PlaceholderOnline, strconv.Itoa(len(player.server.Players())),
+		PlaceholderMax, strconv.Itoa(player.server.MaxPlayers),
+		PlaceholderPing, strconv.FormatInt(player.Ping, 10),
+	)
+
+	pkt := packet.NewPacket(0x4A)
+	pkt.WriteString(header.Replace(r).String()).WriteString(footer.Replace(r).String())
+	player.Send(pkt)
+}
@@ -0,0 +1,400 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/world"
+	log "github.com/sirupsen/logrus"
+)
+
+// TicksPerSecond is how many ticks the server runs in a second when not lagging.
+const TicksPerSecond = 20
+
+// TickDuration is the time of a game tick.
+const TickDuration = time.Second / TicksPerSecond
+
+// maxCatchUp is the most ticks run back to back to catch up after lag,
+// ticks further behind are skipped.
+const maxCatchUp = 2 * TicksPerSecond
+
+// tickHistory is how many ticks are kept to measure TPS and MSPT.
+const tickHistory = 60 * TicksPerSecond
+
+// ticker runs the game loop of a world, the world and players in it are only
+// changed in its loop, connection goroutines hand over actions by Schedule.
+// Each world has its own loop so a slow world doesn't lag the others.
+type ticker struct {
+	world *world.World
+
+	mu    sync.Mutex
+	tasks []func()
+
+	// block changes in this tick, sent together in block update phase
+	changes []BlockChange
+
+	// rand is only used in tick
+	rand *rand.Rand
+
+	// players in world, only used in tick
+	players []*Player
+	// containers opened by players and furnaces burning, only used in tick
+	containers map[containerKey]*blockContainer
+
+	// Tick is the number of ticks run, guarded by statsMu
+	Tick uint64
+
+	statsMu sync.Mutex
+	// start time and duration of latest ticks, as a ring
+	starts    [tickHistory]time.Time
+	durations [tickHistory]time.Duration
+
+	stop, stopped chan struct{}
+}
+
+func newTicker(w *world.World) *ticker {
+	return &ticker{
+		world:      w,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		containers: make(map[containerKey]*blockContainer),
+		stop:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+}
+
+// loop return ticker of w.
+func (s *server) loop(w *world.World) *ticker {
+	return s.tickers[w]
+}
+
+// schedule run fn in the next tick, before anything else in the tick.
+func (t *ticker) schedule(fn func()) {
+	t.mu.Lock()
+	t.tasks = append(t.tasks, fn)
+	t.mu.Unlock()
+}
+
+// Schedule run fn in the next tick of the world player is in,
+// it follows player if player goes to another world before it runs.
+func (player *Player) Schedule(fn func()) {
+	player.worldMu.RLock()
+	defer player.worldMu.RUnlock()
+	w := player.world
+	player.server.loop(w).schedule(func() {
+		if player.World() != w {
+			player.Schedule(fn)
+			return
+		}
+		fn()
+	})
+}
+
+// addPlayer add p to players ticked in t.
+func (t *ticker) addPlayer(p *Player) {
+	t.players = append(t.players, p)
+}
+
+// removePlayer remove p from players ticked in t, report whether it was there.
+func (t *ticker) removePlayer(p *Player) bool {
+	for i, other := range t.players {
+		if other == p {
+			t.players = append(t.players[:i], t.players[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// runTicks run ticks of t at TicksPerSecond until StopTicks.
+func (s *server) runTicks(t *ticker) {
+	defer close(t.stopped)
+
+	next := time.Now()
+	for {
+		select {
+		case <-t.stop:
+			return
+		default:
+		}
+
+		s.tick(t)
+
+		next = next.Add(TickDuration)
+		if behind := time.Since(next); behind > maxCatchUp*TickDuration {
+			log.WithField("world", t.world.Name).Warnf("can't keep up, running %s behind, skipping %d ticks", behind, behind/TickDuration)
+			next = time.Now()
+		}
+		if wait := time.Until(next); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-t.stop:
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// StopTicks stop loops of all worlds and wait the running ticks done.
+func (s *server) StopTicks() {
+	for _, t := range s.tickers {
+		select {
+		case <-t.stop:
+		default:
+			close(t.stop)
+		}
+	}
+	for _, t := range s.tickers {
+		<-t.stopped
+	}
+}
+
+func (s *server) tick(t *ticker) {
+	start := time.Now()
+	w := t.world
+
+	// incoming packets
+	t.mu.Lock()
+	tasks := t.tasks
+	t.tasks = nil
+	t.mu.Unlock()
+	for _, task := range tasks {
+		task()
+	}
+
+	// players leaving world in tasks are ticked in the new world
+	players := append([]*Player(nil), t.players...)
+
+	// world
+	s.tickWorld(w, t.Tick+1)
+	s.tickContainers(t)
+
+	// entities
+	for _, p := range players {
+		p.tickDigging()
+		p.tickWindow()
+		p.tickHealth()
+	}
+
+	// block updates
+	s.flushBlockChanges(t)
+
+	// chunks
+	s.sendModifiedSections(w)
+	for _, p := range players {
+		p.sendQueuedChunks(s.ChunksPerTick)
+	}
+	if (t.Tick+1)%unloadInterval == 0 {
+		s.unloadChunks(t)
+	}
+
+	// outgoing
+	for _, p := range players {
+		p.Flush()
+	}
+
+	t.statsMu.Lock()
+	t.Tick++
+	i := t.Tick % tickHistory
+	t.starts[i], t.durations[i] = start, time.Since(start)
+	t.statsMu.Unlock()
+}
+
+// tickWorld run a tick of w, each world keeps its own time and weather.
+func (s *server) tickWorld(w *world.World, tick uint64) {
+	s.tickTime(w, tick)
+	s.tickWeather(w)
+}
+
+// maxBlockChanges is the most block changes of a chunk sent in a tick,
+// like vanilla the modified sections are sent instead if there are more.
+const maxBlockChanges = 64
+
+// flushBlockChanges send block changes of this tick to players who loaded the chunks,
+// changes in the same chunk are sent in one Multi Block Change.
+func (s *server) flushBlockChanges(t *ticker) {
+	t.mu.Lock()
+	changes := t.changes
+	t.changes = nil
+	t.mu.Unlock()
+	if len(changes) == 0 {
+		return
+	}
+
+	w := t.world
+	var order []world.ChunkPos
+	byChunk := make(map[world.ChunkPos][]BlockChange)
+	for _, c := range changes {
+		key := chunkOf(c.Pos)
+		list, ok := byChunk[key]
+		if !ok {
+			order = append(order, key)
+		}
+		// a block changed twice in a tick is sent once
+		replaced := false
+		for i := range list {
+			if list[i].Pos == c.Pos {
+				list[i].State, replaced = c.State, true
+			}
+		}
+		if !replaced {
+			list = append(list, c)
+		}
+		byChunk[key] = list
+	}
+
+	for _, pos := range order {
+		// client relights blocks changed, sections are only sent for many changes
+		chunk := w.Chunk(pos.X, pos.Z)
+		sections := chunk.TakeModified()
+		var pkt packet.Packet
+		if list := byChunk[pos]; len(list) >= maxBlockChanges {
+			pkt = chunk.SectionsPacket(sections, w.HasSkyLight())
+		} else if len(list) == 1 {
+			pkt = NewBlockChangePacket(list[0].Pos, list[0].State)
+		} else {
+			pkt = NewMultiBlockChangePacket(pos, list)
+		}
+		s.SendToChunk(w, pos, pkt, nil)
+	}
+}
+
+// TPS return ticks per second of t measured over the latest d, at most TicksPerSecond.
+func (t *ticker) TPS(d time.Duration) float64 {
+	t.statsMu.Lock()
+	defer t.statsMu.Unlock()
+
+	if t.Tick < 2 {
+		return TicksPerSecond
+	}
+	n := uint64(d / TickDuration)
+	if n >= tickHistory {
+		n = tickHistory - 1
+	}
+	if n > t.Tick-1 {
+		n = t.Tick - 1
+	}
+	last, first := t.starts[t.Tick%tickHistory], t.starts[(t.Tick-n)%tickHistory]
+	tps := float64(n) / last.Sub(first).Seconds()
+	if tps > TicksPerSecond {
+		tps = TicksPerSecond
+	}
+	return tps
+}
+
+// MSPT return average, min and max milliseconds per tick of the latest n ticks of t.
+func (t *ticker) MSPT(n int) (avg, min, max float64) {
+	t.statsMu.Lock()
+	defer t.statsMu.Unlock()
+
+	if uint64(n) > t.Tick {
+		n = int(t.Tick)
+	}
+	if n > tickHistory {
+		n = tickHistory
+	}
+	if n == 0 {
+		return 0, 0, 0
+	}
+	var total time.Duration
+	for i := 0; i < n; i++ {
+		ms := float64(t.durations[(t.Tick-uint64(i))%tickHistory]) / float64(time.Millisecond)
+		if i == 0 || ms < min {
+			min = ms
+		}
+		if ms > max {
+			max = ms
+		}
+		total += t.durations[(t.Tick-uint64(i))%tickHistory]
+	}
+	return float64(total) / float64(n) / float64(time.Millisecond), min, max
+}
+
+// tpsColor return chat color of tps, green when healthy.
+func tpsColor(tps float64) string {
+	switch {
+	case tps >= 18:
+		return "green"
+	case tps >= 15:
+		return "yellow"
+	default:
+		return "red"
+	}
+}
+
+// SendTPS tell player TPS of the latest 5s, 1m and MSPT of the latest 5s
+// of the world player is in.
+func (player *Player) SendTPS() {
+	t := player.server.loop(player.world)
+	tps5s, tps1m := t.TPS(5*time.Second), t.TPS(time.Minute)
+	avg, min, max := t.MSPT(5 * TicksPerSecond)
+	player.SendChat(Chat{
+		Text: fmt.Sprintf("世界 %s TPS (5s, 1m): ", t.world.Name),
+		Extra: []Chat{
+			{Text: fmt.Sprintf("%.1f", tps5s), Color: tpsColor(tps5s)},
+			{Text: ", "},
+			{Text: fmt.Sprintf("%.1f", tps1m), Color: tpsColor(tps1m)},
+		},
+	})
+	player.SendChat(Chat{Text: fmt.Sprintf("MSPT (平均/最小/最大): %.2f/%.2f/%.2f", avg, min, max)})
+}
+
+// Send queue pkt to player, packets of joined players are written
+// in the outgoing phase of tick, others are written at once.
+func (player *Player) Send(pkt packet.Packet) {
+	player.outMu.Lock()
+	if player.buffered {
+		player.outbox = append(player.outbox, pkt)
+		player.outMu.Unlock()
+		return
+	}
+	player.outMu.Unlock()
+	player.write([]packet.Packet{pkt})
+}
+
+// maxOutbox is the most packets kept for a busy connection,
+// players falling further behind are disconnected.
+const maxOutbox = 16384
+
+// Flush hand packets queued in tick to the connection,
+// they are kept to the next tick if connection is busy.
+func (player *Player) Flush() {
+	player.outMu.Lock()
+	defer player.outMu.Unlock()
+	if len(player.outbox) == 0 {
+		return
+	}
+	select {
+	case player.sendCh <- player.outbox:
+		player.outbox = nil
+	case <-player.doneCh:
+		player.outbox = nil
+	default:
+		if len(player.outbox) > maxOutbox {
+			log.WithField("player", player.Meta.User).Warnf("disconnect player %d packets behind", len(player.outbox))
+			player.outbox = nil
+			player.Close()
+		}
+	}
+}
+
+// setBuffered switch whether packets wait for the outgoing phase of tick.
+func (player *Player) setBuffered(buffered bool) {
+	player.outMu.Lock()
+	player.buffered = buffered
+	player.outMu.Unlock()
+	if !buffered {
+		player.Flush()
+	}
+}
+
+func (player *Player) write(pkts []packet.Packet) {
+	select {
+	case player.sendCh <- pkts:
+	case <-player.doneCh:
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/world"
+)
+
+// timeUpdateInterval is ticks between Time Update to keep clients in sync,
+// clients move the sun themselves between updates.
+const timeUpdateInterval = TicksPerSecond
+
+// named times of /time set
+var namedTimes = map[string]int64{
+	"day":      world.TimeDay,
+	"noon":     world.TimeNoon,
+	"night":    world.TimeNight,
+	"midnight": world.TimeMidnight,
+}
+
+// NewTimeUpdatePacket build Time Update, time of day is sent negative
+// to stop the sun on client if it is not moving.
+func NewTimeUpdatePacket(age, dayTime int64, cycle bool) packet.Packet {
+	if !cycle {
+		dayTime = -dayTime
+		if dayTime == 0 {
+			// -0 would still move
+			dayTime = -1
+		}
+	}
+	pkt := packet.NewPacket(0x47)
+	pkt.WriteLong(uint64(age)).WriteLong(uint64(dayTime))
+	return pkt
+}
+
+// timePacket return Time Update of w now.
+func timePacket(w *world.World) packet.Packet {
+	return NewTimeUpdatePacket(w.Time())
+}
+
+// tickTime advance time of w, and sync time to players every timeUpdateInterval.
+func (s *server) tickTime(w *world.World, tick uint64) {
+	w.TickTime()
+	if tick%timeUpdateInterval == 0 {
+		s.BroadcastTime(w)
+	}
+}
+
+// BroadcastTime send time of w to all players in it.
+func (s *server) BroadcastTime(w *world.World) {
+	s.SendToWorld(w, timePacket(w))
+}
+
+// timeCommand handle /time set|add|query.
+func (player *Player) timeCommand(args []string) {
+	w := player.world
+	if len(args) < 2 {
+		player.SendError("用法: /time <set|add|query> <值>")
+		return
+	}
+
+	switch args[0] {
+	case "set", "add":
+		value, ok := namedTimes[args[1]]
+		if !ok || args[0] == "add" {
+			v, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || v < 0 {
+				player.SendError(fmt.Sprintf("无效的时间: %s", args[1]))
+				return
+			}
+			value = v
+		}
+		if args[0] == "add" {
+			_, dayTime, _ := w.Time()
+			value += dayTime
+		}
+		w.SetDayTime(value)
+		player.server.BroadcastTime(w)
+		player.SendChat(Chat{Text: fmt.Sprintf("时间已设置为 %d", value)})
+	case "query":
+		age, dayTime, _ := w.Time()
+		switch args[1] {
+		case "daytime":
+			player.SendChat(Chat{Text: fmt.Sprintf("当前时间为 %d", dayTime%world.DayLength)})
+		case "gametime":
+			player.SendChat(Chat{Text: fmt.Sprintf("游戏时长为 %d", age)})
+		case "day":
+			player.SendChat(Chat{Text: fmt.Sprintf("已经过了 %d 天", dayTime/world.DayLength)})
+		default:
+			player.SendError("用法: /time query <daytime|gametime|day>")
+		}
+	default:
+		player.SendError("用法: /time <set|add|query> <值>")
+	}
+}
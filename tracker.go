@@ -0,0 +1,266 @@
+package main
+
+import (
+	"math"
+	"sync"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/stream"
+)
+
+// animation id of clientbound Animation
+const (
+	AnimationSwingMainArm = 0
+	AnimationTakeDamage   = 1
+	AnimationLeaveBed     = 2
+	AnimationSwingOffhand = 3
+)
+
+// EntityTracker keep which players each player can see,
+// spawn or destroy them by distance and relay their movement.
+type EntityTracker struct {
+	// Range in blocks that a player can see others
+	Range float64
+
+	mu sync.Mutex
+	// watcher -> players it has spawned
+	watching map[*Player]map[*Player]struct{}
+	// last position and look told to watchers
+	last map[*Player]PositionAndLook
+}
+
+type delivery struct {
+	to  *Player
+	pkt packet.Packet
+}
+
+func NewEntityTracker(r float64) *EntityTracker {
+	return &EntityTracker{
+		Range:    r,
+		watching: make(map[*Player]map[*Player]struct{}),
+		last:     make(map[*Player]PositionAndLook),
+	}
+}
+
+func (t *EntityTracker) inRange(a, b *Player) bool {
+	if a.world != b.world {
+		return false
+	}
+	dx, dz := a.PL.X-b.PL.X, a.PL.Z-b.PL.Z
+	return math.Abs(dx) <= t.Range && math.Abs(dz) <= t.Range
+}
+
+// Update is called after p moved (or joined), it spawns and destroys players around p,
+// and relays p's movement to whom can see it.
+func (t *EntityTracker) Update(p *Player, players []*Player) {
+	t.mu.Lock()
+	var out []delivery
+
+	if _, ok := t.watching[p]; !ok {
+		t.watching[p] = make(map[*Player]struct{})
+	}
+	last, tracked := t.last[p]
+	t.last[p] = p.PL
+
+	var movement []packet.Packet
+	if tracked {
+		movement = newMovementPackets(p, last)
+	}
+
+	for _, other := range players {
+		if other == p {
+			continue
+		}
+		if _, ok := t.watching[other]; !ok {
+			// other is not ready to track
+			continue
+		}
+
+		near := t.inRange(p, other)
+
+		// other watching p
+		if _, ok := t.watching[other][p]; ok {
+			if near {
+				for _, pkt := range movement {
+					out = append(out, delivery{other, pkt})
+				}
+			} else {
+				delete(t.watching[other], p)
+				out = append(out, delivery{other, NewDestroyEntitiesPacket(p.ID())})
+			}
+		} else if near {
+			t.watching[other][p] = struct{}{}
+			for _, pkt := range newSpawnPlayerPackets(p) {
+				out = append(out, delivery{other, pkt})
+			}
+		}
+
+		// p watching other
+		if _, ok := t.watching[p][other]; ok {
+			if !near {
+				delete(t.watching[p], other)
+				out = append(out, delivery{p, NewDestroyEntitiesPacket(other.ID())})
+			}
+		} else if near {
+			t.watching[p][other] = struct{}{}
+			for _, pkt := range newSpawnPlayerPackets(other) {
+				out = append(out, delivery{p, pkt})
+			}
+		}
+	}
+	t.mu.Unlock()
+
+	for _, d := range out {
+		d.to.Send(d.pkt)
+	}
+}
+
+// Remove p from tracker, and destroy p in whom can see it.
+func (t *EntityTracker) Remove(p *Player) {
+	t.mu.Lock()
+	var watchers []*Player
+	for watcher, seen := range t.watching {
+		if _, ok := seen[p]; ok {
+			delete(seen, p)
+			watchers = append(watchers, watcher)
+		}
+	}
+	delete(t.watching, p)
+	delete(t.last, p)
+	t.mu.Unlock()
+
+	for _, watcher := range watchers {
+		watcher.Send(NewDestroyEntitiesPacket(p.ID()))
+	}
+}
+
+// Watchers return players who can see p.
+func (t *EntityTracker) Watchers(p *Player) []*Player {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var watchers []*Player
+	for watcher, seen := range t.watching {
+		if _, ok := seen[p]; ok {
+			watchers = append(watchers, watcher)
+		}
+	}
+	return watchers
+}
+
+// SendToWatchers send pkt to players who can see p.
+func (t *EntityTracker) SendToWatchers(p *Player, pkt packet.Packet) {
+	for _, watcher := range t.Watchers(p) {
+		watcher.Send(pkt)
+	}
+}
+
+// toAngle convert degree to steps of 1/256 of a full turn.
+func toAngle(degree float32) byte {
+	return byte(int32(degree * 256 / 360))
+}
+
+func newSpawnPlayerPackets(p *Player) []packet.Packet {
+	spawnPlayer := packet.NewPacket(0x05)
+	spawnPlayer.WriteVarInt(uint64(p.ID())).
+		WriteUUID(p.Meta.UserID).
+		WriteDouble(p.PL.X).
+		WriteDouble(p.PL.Y).
+		WriteDouble(p.PL.Z).
+		WriteUByte(toAngle(p.PL.Yaw)).
+		WriteUByte(toAngle(p.PL.Pitch)).
+		WriteMetadata(p.meta)
+
+	return []packet.Packet{spawnPlayer, NewEntityHeadLookPacket(p)}
+}
+
+// newMovementPackets choose the smallest packets to tell watchers p moved from last.
+func newMovementPackets(p *Player, last PositionAndLook) []packet.Packet {
+	cur := p.PL
+	moved := cur.X != last.X || cur.Y != last.Y || cur.Z != last.Z
+	looked := cur.Yaw != last.Yaw || cur.Pitch != last.Pitch
+
+	// relative move is (cur * 32 - prev * 32) * 128 in a short
+	dx := int64(cur.X*4096) - int64(last.X*4096)
+	dy := int64(cur.Y*4096) - int64(last.Y*4096)
+	dz := int64(cur.Z*4096) - int64(last.Z*4096)
+	far := dx < math.MinInt16 || dx > math.MaxInt16 ||
+		dy < math.MinInt16 || dy > math.MaxInt16 ||
+		dz < math.MinInt16 || dz > math.MaxInt16
+
+	var pkts []packet.Packet
+	switch {
+	case far:
+		// Entity Teleport
+		pkt := packet.NewPacket(0x4C)
+		pkt.WriteVarInt(uint64(p.ID())).
+			WriteDouble(cur.X).
+			WriteDouble(cur.Y).
+			WriteDouble(cur.Z).
+			WriteUByte(toAngle(cur.Yaw)).
+			WriteUByte(toAngle(cur.Pitch)).
+			WriteBoolean(cur.OnGround)
+		pkts = append(pkts, pkt)
+	case moved && looked:
+		// Entity Look And Relative Move
+		pkt := packet.NewPacket(0x27)
+		pkt.WriteVarInt(uint64(p.ID())).
+			WriteShort(uint16(dx)).
+			WriteShort(uint16(dy)).
+			WriteShort(uint16(dz)).
+			WriteUByte(toAngle(cur.Yaw)).
+			WriteUByte(toAngle(cur.Pitch)).
+			WriteBoolean(cur.OnGround)
+		pkts = append(pkts, pkt)
+	case moved:
+		// Entity Relative Move
+		pkt := packet.NewPacket(0x26)
+		pkt.WriteVarInt(uint64(p.ID())).
+			WriteShort(uint16(dx)).
+			WriteShort(uint16(dy)).
+			WriteShort(uint16(dz)).
+			WriteBoolean(cur.OnGround)
+		pkts = append(pkts, pkt)
+	case looked:
+		// Entity Look
+		pkt := packet.NewPacket(0x28)
+		pkt.WriteVarInt(uint64(p.ID())).
+			WriteUByte(toAngle(cur.Yaw)).
+			WriteUByte(toAngle(cur.Pitch)).
+			WriteBoolean(cur.OnGround)
+		pkts = append(pkts, pkt)
+	}
+
+	if cur.Yaw != last.Yaw {
+		pkts = append(pkts, NewEntityHeadLookPacket(p))
+	}
+	return pkts
+}
+
+func NewEntityHeadLookPacket(p *Player) packet.Packet {
+	pkt := packet.NewPacket(0x36)
+	pkt.WriteVarInt(uint64(p.ID())).WriteUByte(toAngle(p.PL.Yaw))
+	return pkt
+}
+
+func NewDestroyEntitiesPacket(ids ...int32) packet.Packet {
+	pkt := packet.NewPacket(0x32)
+	pkt.WriteVarInt(uint64(len(ids)))
+	for _, id := range ids {
+		pkt.WriteVarInt(uint64(id))
+	}
+	return pkt
+}
+
+func NewAnimationPacket(entityID int32, animation byte) packet.Packet {
+	pkt := packet.NewPacket(0x06)
+	pkt.WriteVarInt(uint64(entityID)).WriteUByte(animation)
+	return pkt
+}
+
+// NewEntityMetadataPacket build Entity Metadata with entries changed since last flush.
+func NewEntityMetadataPacket(entityID int32, m *stream.Metadata) packet.Packet {
+	pkt := packet.NewPacket(0x3C)
+	pkt.WriteVarInt(uint64(entityID)).WriteDirtyMetadata(m)
+	return pkt
+}
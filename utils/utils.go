@@ -2,8 +2,8 @@ package utils
 
 import "encoding/binary"
 
-var varIntLenBuf = [10]byte{}
-
 func UvarintLen(num uint64) (size int) {
-	return binary.PutUvarint(varIntLenBuf[:], num)
+	// buffer on stack, connections count lengths at the same time
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], num)
 }
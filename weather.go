@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/world"
+)
+
+// reason of Change Game State
+const (
+	GameStateInvalidBed   = 0
+	GameStateBeginRaining = 1 // wiki.vg of 1.12 swaps begin and end raining
+	GameStateEndRaining   = 2
+	GameStateChangeMode   = 3
+	GameStateExitEnd      = 4
+	GameStateDemoMessage  = 5
+	GameStateArrowHit     = 6
+	GameStateRainLevel    = 7
+	GameStateThunderLevel = 8
+)
+
+// global entity type of Spawn Global Entity
+const globalEntityThunderbolt = 1
+
+// category of sound effects
+const soundCategoryWeather = 3
+
+// lightningChance is 1 in how many chances a loaded chunk is struck in a tick while thundering.
+const lightningChance = 100000
+
+func NewChangeGameStatePacket(reason byte, value float32) packet.Packet {
+	pkt := packet.NewPacket(0x1E)
+	pkt.WriteUByte(reason).WriteFloat(value)
+	return pkt
+}
+
+// NewSpawnGlobalEntityPacket build Spawn Global Entity of a thunderbolt at x, y, z.
+func NewSpawnGlobalEntityPacket(entityID int32, x, y, z float64) packet.Packet {
+	pkt := packet.NewPacket(0x02)
+	pkt.WriteVarInt(uint64(entityID)).
+		WriteUByte(globalEntityThunderbolt).
+		WriteDouble(x).
+		WriteDouble(y).
+		WriteDouble(z)
+	return pkt
+}
+
+// NewNamedSoundEffectPacket build Named Sound Effect of sound at x, y, z.
+func NewNamedSoundEffectPacket(sound string, category int, x, y, z float64, volume, pitch float32) packet.Packet {
+	pkt := packet.NewPacket(0x19)
+	pkt.WriteString(sound).
+		WriteVarInt(uint64(category)).
+		WriteInt(uint32(int32(x * 8))).
+		WriteInt(uint32(int32(y * 8))).
+		WriteInt(uint32(int32(z * 8))).
+		WriteFloat(volume).
+		WriteFloat(pitch)
+	return pkt
+}
+
+// weatherPackets return packets telling weather of w to players who just came in.
+func weatherPackets(w *world.World) []packet.Packet {
+	if !w.HasSkyLight() {
+		return nil
+	}
+	weather := w.Weather()
+	if !weather.Raining && weather.RainLevel == 0 {
+		return nil
+	}
+	return []packet.Packet{
+		NewChangeGameStatePacket(GameStateBeginRaining, 0),
+		NewChangeGameStatePacket(GameStateRainLevel, weather.RainLevel),
+		NewChangeGameStatePacket(GameStateThunderLevel, weather.ThunderLevel),
+	}
+}
+
+// tickWeather advance weather of w, tell players what changed and strike lightning,
+// there is no weather in worlds without sky.
+func (s *server) tickWeather(w *world.World) {
+	if !w.HasSkyLight() {
+		return
+	}
+	r := s.loop(w).rand
+	before, after := w.TickWeather(r)
+
+	if before.Raining != after.Raining {
+		reason := byte(GameStateEndRaining)
+		if after.Raining {
+			reason = GameStateBeginRaining
+		}
+		s.SendToWorld(w, NewChangeGameStatePacket(reason, 0))
+	}
+	if before.RainLevel != after.RainLevel {
+		s.SendToWorld(w, NewChangeGameStatePacket(GameStateRainLevel, after.RainLevel))
+	}
+	if before.ThunderLevel != after.ThunderLevel {
+		s.SendToWorld(w, NewChangeGameStatePacket(GameStateThunderLevel, after.ThunderLevel))
+	}
+
+	if after.Raining && after.Thundering {
+		for _, pos := range w.LoadedChunks() {
+			if r.Intn(lightningChance) == 0 {
+				x, z := int(pos.X)<<4|r.Intn(16), int(pos.Z)<<4|r.Intn(16)
+				s.StrikeLightning(w, x, z)
+			}
+		}
+	}
+}
+
+// StrikeLightning strike lightning on top of column x, z of w.
+func (s *server) StrikeLightning(w *world.World, x, z int) {
+	y := w.Chunk(int32(x>>4), int32(z>>4)).Height(x&0xF, z&0xF)
+	fx, fy, fz := float64(x)+0.5, float64(y), float64(z)+0.5
+	pos := world.ChunkPos{X: int32(x >> 4), Z: int32(z >> 4)}
+	r := s.loop(w).rand
+
+	// thunder is heard by everyone far away, impact only nearby
+	s.SendToChunk(w, pos, NewSpawnGlobalEntityPacket(world.NextEntityID(), fx, fy, fz), nil)
+	s.SendToWorld(w, NewNamedSoundEffectPacket("entity.lightning.thunder", soundCategoryWeather,
+		fx, fy, fz, 10000, 0.8+r.Float32()*0.2))
+	s.SendToChunk(w, pos, NewNamedSoundEffectPacket("entity.lightning.impact", soundCategoryWeather,
+		fx, fy, fz, 2, 0.5+r.Float32()*0.2), nil)
+}
+
+// weatherCommand handle /weather clear|rain|thunder [duration in seconds].
+func (player *Player) weatherCommand(args []string) {
+	if len(args) == 0 {
+		player.SendError("用法: /weather <clear|rain|thunder> [秒数]")
+		return
+	}
+	duration := world.RandomWeatherDuration(player.server.loop(player.world).rand)
+	if len(args) > 1 {
+		seconds, err := strconv.Atoi(args[1])
+		if err != nil || seconds < 1 || seconds > 1000000 {
+			player.SendError(fmt.Sprintf("无效的时长: %s", args[1]))
+			return
+		}
+		duration = int32(seconds) * TicksPerSecond
+	}
+
+	w := player.world
+	switch args[0] {
+	case "clear":
+		w.SetWeather(false, false, duration)
+		player.SendChat(Chat{Text: "天气已设置为晴天"})
+	case "rain":
+		w.SetWeather(true, false, duration)
+		player.SendChat(Chat{Text: "天气已设置为雨天"})
+	case "thunder":
+		w.SetWeather(true, true, duration)
+		player.SendChat(Chat{Text: "天气已设置为雷雨"})
+	default:
+		player.SendError("用法: /weather <clear|rain|thunder> [秒数]")
+	}
+}
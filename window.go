@@ -0,0 +1,697 @@
+package main
+
+import (
+	"github.com/laushunyu/real/constants"
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+)
+
+// modes of Click Window
+const (
+	ClickNormal = iota
+	ClickShift
+	ClickNumberKey
+	ClickMiddle
+	ClickDrop
+	ClickDrag
+	ClickDouble
+)
+
+// stages and kinds of drag painting, button of Click Window is kind<<2 | stage
+const (
+	dragStart = 0
+	dragAdd   = 1
+	dragEnd   = 2
+
+	dragLeft   = 0
+	dragRight  = 1
+	dragMiddle = 2
+)
+
+// maxWindowReach is how far in blocks a player can be from the block of an open window.
+const maxWindowReach = 8
+
+// Window is a window opened by player, slots of container come first
+// and are followed by the main inventory and hotbar of player.
+type Window struct {
+	ID    byte
+	Type  string
+	Title Chat
+	// container is nil for player inventory
+	container Container
+	inv       *Inventory
+
+	// block opened, window is closed once it changed or player walked away
+	kind  *containerKind
+	world *world.World
+	pos   stream.Position
+
+	// rejected is set after a click is refused, clicks are ignored until client confirms
+	rejected bool
+	// drag painting in progress
+	dragKind  int
+	dragSlots []int
+}
+
+func newInventoryWindow(inv *Inventory) *Window {
+	return &Window{ID: WindowInventory, inv: inv}
+}
+
+// Size return number of slots in window.
+func (w *Window) Size() int {
+	if w.container == nil {
+		return InventorySize
+	}
+	return w.container.Size() + SlotHotbarEnd - SlotMainStart + 1
+}
+
+// invSlot return slot of player inventory of window slot i, -1 if it is in container.
+func (w *Window) invSlot(i int) int {
+	if w.container == nil {
+		return i
+	}
+	n := w.container.Size()
+	if i < n {
+		return -1
+	}
+	return SlotMainStart + i - n
+}
+
+// hotbarSlot return window slot of hotbar slot n.
+func (w *Window) hotbarSlot(n int) int {
+	if w.container == nil {
+		return SlotHotbarStart + n
+	}
+	return w.container.Size() + SlotHotbarStart - SlotMainStart + n
+}
+
+func (w *Window) valid(i int) bool {
+	return i >= 0 && i < w.Size()
+}
+
+func (w *Window) Slot(i int) stream.Slot {
+	if !w.valid(i) {
+		return stream.EmptySlot
+	}
+	if slot := w.invSlot(i); slot >= 0 {
+		return w.inv.Slot(slot)
+	}
+	return w.container.Slot(i)
+}
+
+func (w *Window) SetSlot(i int, it stream.Slot) {
+	if !w.valid(i) {
+		return
+	}
+	if slot := w.invSlot(i); slot >= 0 {
+		w.inv.SetSlot(slot, it)
+		return
+	}
+	w.container.SetSlot(i, it)
+}
+
+// CanPlace report whether it can be put into window slot i.
+func (w *Window) CanPlace(i int, it stream.Slot) bool {
+	if !w.valid(i) {
+		return false
+	}
+	if slot := w.invSlot(i); slot >= 0 {
+		return canPlace(slot, it)
+	}
+	return w.container.CanPlace(i, it)
+}
+
+// takeOnly report whether items in slot i can only be taken out, like crafting results.
+func (w *Window) takeOnly(i int) bool {
+	it := w.Slot(i)
+	return !it.Empty() && !w.CanPlace(i, it)
+}
+
+// Slots return all slots of window in order.
+func (w *Window) Slots() []stream.Slot {
+	slots := make([]stream.Slot, w.Size())
+	for i := range slots {
+		slots[i] = w.Slot(i)
+	}
+	return slots
+}
+
+// slotRange return window slots from start to end, backwards if start > end.
+func slotRange(start, end int) []int {
+	step := 1
+	if start > end {
+		step = -1
+	}
+	slots := make([]int, 0, (end-start)*step+1)
+	for i := start; i != end+step; i += step {
+		slots = append(slots, i)
+	}
+	return slots
+}
+
+// shiftTargets return slots where items in slot i go by shift click, like vanilla.
+func (w *Window) shiftTargets(i int, it stream.Slot) []int {
+	if w.container == nil {
+		armor := armorSlot(it)
+		switch {
+		case i == SlotCraftResult:
+			return slotRange(SlotHotbarEnd, SlotMainStart)
+		case armor >= 0 && (i < SlotHead || i > SlotFeet) && w.Slot(armor).Empty():
+			return []int{armor}
+		case i >= SlotMainStart && i <= SlotMainEnd:
+			return slotRange(SlotHotbarStart, SlotHotbarEnd)
+		case i >= SlotHotbarStart && i <= SlotHotbarEnd:
+			return slotRange(SlotMainStart, SlotMainEnd)
+		}
+		return slotRange(SlotMainStart, SlotHotbarEnd)
+	}
+
+	n := w.container.Size()
+	main, hotbar := slotRange(n, n+SlotMainEnd-SlotMainStart), slotRange(w.hotbarSlot(0), w.hotbarSlot(8))
+	if i < n {
+		// into player inventory from hotbar end
+		return slotRange(w.hotbarSlot(8), n)
+	}
+	var targets []int
+	if st, ok := w.container.(shiftTargeter); ok {
+		targets = st.ShiftSlots(it)
+	} else {
+		targets = slotRange(0, n-1)
+	}
+	if len(targets) == 0 {
+		if w.invSlot(i) <= SlotMainEnd {
+			return hotbar
+		}
+		return main
+	}
+	return targets
+}
+
+// moveInto put it into slots, stacks are filled before empty slots. It returns items left.
+func (w *Window) moveInto(it stream.Slot, slots []int) stream.Slot {
+	max := MaxStack(it)
+	for _, i := range slots {
+		s := w.Slot(i)
+		if it.Empty() {
+			break
+		}
+		if s.Empty() || !Stackable(s, it) || int(s.Count) >= max || !w.CanPlace(i, it) {
+			continue
+		}
+		n := minInt(int(it.Count), max-int(s.Count))
+		s.Count += byte(n)
+		it.Count -= byte(n)
+		w.SetSlot(i, s)
+	}
+	for _, i := range slots {
+		if it.Empty() {
+			break
+		}
+		if !w.Slot(i).Empty() || !w.CanPlace(i, it) {
+			continue
+		}
+		s := it
+		s.Count = byte(minInt(int(it.Count), max))
+		it.Count -= s.Count
+		w.SetSlot(i, s)
+	}
+	if it.Empty() {
+		return stream.EmptySlot
+	}
+	return it
+}
+
+// NewOpenWindowPacket build Open Window, slots is 0 for windows keeping nothing like crafting table.
+func NewOpenWindowPacket(id byte, typ string, title Chat, slots byte) packet.Packet {
+	pkt := packet.NewPacket(0x13)
+	pkt.WriteUByte(id).WriteString(typ).WriteString(title.String()).WriteUByte(slots)
+	return pkt
+}
+
+// NewCloseWindowPacket build clientbound Close Window.
+func NewCloseWindowPacket(id byte) packet.Packet {
+	pkt := packet.NewPacket(0x12)
+	pkt.WriteUByte(id)
+	return pkt
+}
+
+// NewConfirmTransactionPacket build clientbound Confirm Transaction of a click,
+// client sends it back if not accepted.
+func NewConfirmTransactionPacket(id byte, action int16, accepted bool) packet.Packet {
+	pkt := packet.NewPacket(0x11)
+	pkt.WriteUByte(id).WriteShort(uint16(action)).WriteBoolean(accepted)
+	return pkt
+}
+
+// openWindow return window opened by player, player inventory if nothing else.
+func (player *Player) openWindow() *Window {
+	if player.window != nil {
+		return player.window
+	}
+	return player.invWindow
+}
+
+// OpenContainer open window of container block at pos in w.
+func (player *Player) OpenContainer(w *world.World, pos stream.Position, kind *containerKind) {
+	player.CloseWindow(false)
+
+	var c Container
+	if kind.BlockEntity != "" {
+		c = player.server.openContainer(w, pos, kind)
+	} else {
+		c = newGridContainer(kind)
+	}
+	player.windowID = player.windowID%100 + 1
+	win := &Window{
+		ID:        player.windowID,
+		Type:      kind.Type,
+		Title:     Chat{Text: kind.Title},
+		container: c,
+		inv:       player.inventory,
+		kind:      kind,
+		world:     w,
+		pos:       pos,
+	}
+	player.window = win
+
+	player.Send(NewOpenWindowPacket(win.ID, win.Type, win.Title, byte(kind.Slots)))
+	player.syncWindow(win)
+	if bc, ok := c.(*blockContainer); ok {
+		bc.Open(player)
+	}
+}
+
+// CloseWindow close window opened other than inventory, items on cursor go back to inventory.
+// Client is told if send is true, otherwise client closed it.
+func (player *Player) CloseWindow(send bool) {
+	win := player.window
+	if win == nil {
+		return
+	}
+	player.window = nil
+	if c, ok := win.container.(closer); ok {
+		c.Close(player)
+	}
+	if inv := player.inventory; !inv.Cursor.Empty() {
+		inv.Add(inv.Cursor)
+		inv.Cursor = stream.EmptySlot
+	}
+	if send {
+		player.Send(NewCloseWindowPacket(win.ID))
+	}
+	player.SendInventory()
+}
+
+// HandleCloseWindow handle Close Window from client.
+func (player *Player) HandleCloseWindow(id byte) {
+	if id != WindowInventory {
+		if player.window != nil && player.window.ID == id {
+			player.CloseWindow(false)
+		}
+		return
+	}
+
+	// items on cursor and in crafting grid go back to inventory
+	inv := player.inventory
+	returned := []stream.Slot{inv.Cursor}
+	inv.Cursor = stream.EmptySlot
+	for slot := SlotCraftStart; slot <= SlotCraftEnd; slot++ {
+		returned = append(returned, inv.Slot(slot))
+		inv.SetSlot(slot, stream.EmptySlot)
+	}
+	inv.SetSlot(SlotCraftResult, stream.EmptySlot)
+	for _, it := range returned {
+		if !it.Empty() {
+			// items can't be dropped yet, the ones not fit are gone
+			inv.Add(it)
+		}
+	}
+	player.SendInventory()
+}
+
+// tickWindow close window if its block is gone or player is too far away.
+func (player *Player) tickWindow() {
+	win := player.window
+	if win == nil {
+		return
+	}
+	eye := player.eyePosition()
+	dx := float64(win.pos.X) + 0.5 - eye.X
+	dy := float64(win.pos.Y) + 0.5 - eye.Y
+	dz := float64(win.pos.Z) + 0.5 - eye.Z
+	b := win.world.Block(int(win.pos.X), int(win.pos.Y), int(win.pos.Z)).Block()
+	gone := b == nil || containerKinds[b.Name] != win.kind
+	if gone || win.world != player.world || dx*dx+dy*dy+dz*dz > maxWindowReach*maxWindowReach {
+		player.CloseWindow(true)
+	}
+}
+
+// syncWindow send all slots of win and the cursor, client drops what it guessed.
+func (player *Player) syncWindow(win *Window) {
+	player.Send(NewWindowItemsPacket(win.ID, win.Slots()))
+	player.Send(NewSetSlotPacket(WindowCursor, SlotCursor, player.inventory.Cursor))
+}
+
+// HandleClickWindow handle Click Window, the click is done on server and accepted
+// if client guessed the same clicked item, otherwise client gets the window again.
+func (player *Player) HandleClickWindow(id byte, slot, button int, action int16, mode int, clicked stream.Slot) {
+	win := player.openWindow()
+	if id != win.ID {
+		// click of a window closed
+		return
+	}
+	if win.rejected {
+		// client is still clicking the window it guessed
+		player.syncWindow(win)
+		return
+	}
+
+	expected, ok := player.click(win, slot, button, mode)
+	accepted := ok && sameItem(expected, clicked)
+	player.Send(NewConfirmTransactionPacket(win.ID, action, accepted))
+	if !accepted {
+		win.rejected = true
+		win.updateCrafting()
+		player.syncWindow(win)
+		return
+	}
+	player.sendResult(win)
+}
+
+// HandleConfirmTransaction handle client confirming a refused click, clicks are taken again.
+func (player *Player) HandleConfirmTransaction(id byte, accepted bool) {
+	if win := player.openWindow(); win.ID == id && accepted {
+		win.rejected = false
+	}
+}
+
+// sameItem report whether a and b are the same items of the same count.
+func sameItem(a, b stream.Slot) bool {
+	if a.Empty() || b.Empty() {
+		return a.Empty() && b.Empty()
+	}
+	return a.Count == b.Count && Stackable(a, b)
+}
+
+// click do a click of mode on window slot like vanilla. It returns the clicked item
+// which client also tells, and false if click is not valid.
+func (player *Player) click(win *Window, slot, button, mode int) (stream.Slot, bool) {
+	inv := player.inventory
+	if mode != ClickDrag && win.dragSlots != nil {
+		// another click stops drag painting
+		win.dragSlots = nil
+	}
+
+	if _, size := win.grid(); slot == SlotCraftResult && size > 0 {
+		if expected, ok := player.takeResult(win, mode, button); ok {
+			return expected, true
+		}
+	}
+
+	switch mode {
+	case ClickNormal:
+		if button > 1 {
+			return stream.EmptySlot, false
+		}
+		if slot == SlotOutside {
+			player.dropCursor(button == 0)
+			return stream.EmptySlot, true
+		}
+		if !win.valid(slot) {
+			return stream.EmptySlot, false
+		}
+		before := win.Slot(slot)
+		return before, player.clickSlot(win, slot, button == 1)
+	case ClickShift:
+		if button > 1 || !win.valid(slot) {
+			return stream.EmptySlot, slot == SlotOutside
+		}
+		before := win.Slot(slot)
+		if player.shiftClick(win, slot) {
+			return before, true
+		}
+		return stream.EmptySlot, true
+	case ClickNumberKey:
+		return stream.EmptySlot, player.swapHotbar(win, slot, button)
+	case ClickMiddle:
+		if it := win.Slot(slot); player.GameMode == constants.GameModeCreative && inv.Cursor.Empty() && !it.Empty() {
+			it.Count = byte(MaxStack(it))
+			inv.Cursor = it
+		}
+		return stream.EmptySlot, true
+	case ClickDrop:
+		if slot == SlotOutside {
+			return stream.EmptySlot, true
+		}
+		if !win.valid(slot) || button > 1 {
+			return stream.EmptySlot, false
+		}
+		// item entities are not spawned yet, dropped items are gone
+		if it := win.Slot(slot); !it.Empty() {
+			if button == 1 {
+				it.Count = 0
+			} else {
+				it.Count--
+			}
+			win.SetSlot(slot, it)
+		}
+		return stream.EmptySlot, true
+	case ClickDrag:
+		return stream.EmptySlot, player.drag(win, slot, button)
+	case ClickDouble:
+		if button != 0 {
+			return stream.EmptySlot, false
+		}
+		player.collect(win)
+		return stream.EmptySlot, true
+	}
+	return stream.EmptySlot, false
+}
+
+// dropCursor throw items on cursor out of window, all or one.
+func (player *Player) dropCursor(all bool) {
+	inv := player.inventory
+	if inv.Cursor.Empty() {
+		return
+	}
+	// item entities are not spawned yet, dropped items are gone
+	if all {
+		inv.Cursor.Count = 0
+	} else {
+		inv.Cursor.Count--
+	}
+	if inv.Cursor.Empty() {
+		inv.Cursor = stream.EmptySlot
+	}
+}
+
+// clickSlot pick up or put down items between cursor and slot like vanilla,
+// right click moves half or one of them. It reports false if nothing can be done.
+func (player *Player) clickSlot(win *Window, slot int, right bool) bool {
+	inv := player.inventory
+	cursor, it := inv.Cursor, win.Slot(slot)
+
+	switch {
+	case cursor.Empty() && it.Empty():
+		return true
+	case cursor.Empty():
+		// pick up all, or the larger half by right click
+		n := it.Count
+		if right && !win.takeOnly(slot) {
+			n = (it.Count + 1) / 2
+		}
+		cursor, it.Count = it, it.Count-n
+		cursor.Count = n
+	case !win.CanPlace(slot, cursor):
+		// take only slots are added to cursor if there is room
+		if !Stackable(it, cursor) || int(cursor.Count+it.Count) > MaxStack(cursor) {
+			return true
+		}
+		cursor.Count += it.Count
+		it.Count = 0
+	case it.Empty() || Stackable(it, cursor):
+		// put down all, or one by right click
+		n := cursor.Count
+		if right {
+			n = 1
+		}
+		if it.Empty() {
+			it = cursor
+			it.Count = 0
+		}
+		n = byte(minInt(int(n), MaxStack(it)-int(it.Count)))
+		it.Count += n
+		cursor.Count -= n
+	default:
+		cursor, it = it, cursor
+	}
+
+	win.SetSlot(slot, it)
+	if cursor.Empty() {
+		cursor = stream.EmptySlot
+	}
+	inv.Cursor = cursor
+	return true
+}
+
+// shiftClick move items in slot to the other part of window, it reports whether any moved.
+func (player *Player) shiftClick(win *Window, slot int) bool {
+	it := win.Slot(slot)
+	if it.Empty() {
+		return false
+	}
+	left := win.moveInto(it, win.shiftTargets(slot, it))
+	if left.Count == it.Count && !left.Empty() {
+		return false
+	}
+	win.SetSlot(slot, left)
+	return true
+}
+
+// swapHotbar swap items in slot and hotbar slot n by number key.
+func (player *Player) swapHotbar(win *Window, slot, n int) bool {
+	if n < 0 || n > 8 || !win.valid(slot) {
+		return false
+	}
+	hotbar := win.hotbarSlot(n)
+	if hotbar == slot {
+		return true
+	}
+	it, held := win.Slot(slot), win.Slot(hotbar)
+	if !held.Empty() && !win.CanPlace(slot, held) {
+		return false
+	}
+	win.SetSlot(slot, held)
+	win.SetSlot(hotbar, it)
+	return true
+}
+
+// drag paint items on cursor over slots, they are put when drag ends:
+// left drag splits evenly, right drag puts one each and middle drag fills each in creative.
+func (player *Player) drag(win *Window, slot, button int) bool {
+	inv := player.inventory
+	kind, stage := button>>2, button&3
+
+	switch stage {
+	case dragStart:
+		if slot != SlotOutside || kind > dragMiddle ||
+			kind == dragMiddle && player.GameMode != constants.GameModeCreative {
+			win.dragSlots = nil
+			return false
+		}
+		win.dragKind, win.dragSlots = kind, []int{}
+		return true
+	case dragAdd:
+		if win.dragSlots == nil || kind != win.dragKind || inv.Cursor.Empty() {
+			return false
+		}
+		it := win.Slot(slot)
+		if !win.valid(slot) || !win.CanPlace(slot, inv.Cursor) || !it.Empty() && !Stackable(it, inv.Cursor) {
+			return false
+		}
+		for _, s := range win.dragSlots {
+			if s == slot {
+				return true
+			}
+		}
+		// like vanilla, there are never more slots than items to spread
+		if kind != dragMiddle && len(win.dragSlots) >= int(inv.Cursor.Count) {
+			return false
+		}
+		win.dragSlots = append(win.dragSlots, slot)
+		return true
+	case dragEnd:
+		slots := win.dragSlots
+		win.dragSlots = nil
+		if slots == nil || kind != win.dragKind {
+			return false
+		}
+		if len(slots) == 0 || inv.Cursor.Empty() {
+			return true
+		}
+		cursor := inv.Cursor
+		max := MaxStack(cursor)
+		each := int(cursor.Count) / len(slots)
+		switch kind {
+		case dragRight:
+			each = 1
+		case dragMiddle:
+			each = max
+		}
+		left := int(cursor.Count)
+		for _, s := range slots {
+			it := win.Slot(s)
+			if it.Empty() {
+				it = cursor
+				it.Count = 0
+			}
+			n := minInt(each, max-int(it.Count))
+			if kind != dragMiddle {
+				n = minInt(n, left)
+				left -= n
+			}
+			it.Count += byte(n)
+			win.SetSlot(s, it)
+		}
+		if kind != dragMiddle {
+			cursor.Count = byte(left)
+			if cursor.Empty() {
+				cursor = stream.EmptySlot
+			}
+			inv.Cursor = cursor
+		}
+		return true
+	}
+	return false
+}
+
+// collect gather items like the one on cursor into it by double click,
+// stacks not full are taken first and take only slots are skipped.
+func (player *Player) collect(win *Window) {
+	inv := player.inventory
+	cursor := inv.Cursor
+	if cursor.Empty() {
+		return
+	}
+	max := MaxStack(cursor)
+	for pass := 0; pass < 2; pass++ {
+		for i := 0; i < win.Size() && int(cursor.Count) < max; i++ {
+			it := win.Slot(i)
+			if it.Empty() || !Stackable(it, cursor) || win.takeOnly(i) {
+				continue
+			}
+			if pass == 0 && int(it.Count) >= MaxStack(it) {
+				continue
+			}
+			n := minInt(int(it.Count), max-int(cursor.Count))
+			it.Count -= byte(n)
+			cursor.Count += byte(n)
+			win.SetSlot(i, it)
+		}
+	}
+	inv.Cursor = cursor
+}
+
+// interact use block at pos by right click, it reports false if nothing to do with the block.
+func (player *Player) interact(pos stream.Position) bool {
+	if !player.canReach(pos) {
+		return true
+	}
+	w := player.world
+	b := w.Block(int(pos.X), int(pos.Y), int(pos.Z)).Block()
+	if b == nil {
+		return false
+	}
+	kind, ok := containerKinds[b.Name]
+	if !ok || player.GameMode == constants.GameModeSpectator {
+		return false
+	}
+	// sneaking players place blocks against containers
+	crouched := world.HasEntityFlag(player.meta, world.EntityFlagCrouched)
+	if crouched && !(player.HeldItem(0).Empty() && player.HeldItem(1).Empty()) {
+		return false
+	}
+	player.OpenContainer(w, pos, kind)
+	return true
+}
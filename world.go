@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/world"
+	"github.com/laushunyu/real/world/anvil"
+	"github.com/laushunyu/real/world/generate"
+	log "github.com/sirupsen/logrus"
+)
+
+// WorldConfig is a world hosted by server, it is saved in <SavesDir>/<Name>.
+type WorldConfig struct {
+	Name      string
+	Dimension int32
+	// LevelType and GeneratorSettings choose generator of a new level,
+	// the ones in level.dat are used once it saved
+	LevelType, GeneratorSettings string
+}
+
+// DefaultWorlds is the overworld, nether and end like vanilla servers,
+// the first one is where players join.
+var DefaultWorlds = []WorldConfig{
+	{Name: "world", Dimension: world.DimensionOverworld, LevelType: generate.NameDefault},
+	{Name: "world_nether", Dimension: world.DimensionNether, LevelType: generate.NameNether},
+	{Name: "world_the_end", Dimension: world.DimensionEnd, LevelType: generate.NameEnd},
+}
+
+// loadWorld load level of world in config, or create a new one.
+func (s *server) loadWorld(cfg WorldConfig) *world.World {
+	w := world.NewWorld(cfg.Name, nil)
+	w.Dimension = cfg.Dimension
+	w.Storage = anvil.NewDimensionStorage(filepath.Join(s.SavesDir, cfg.Name), cfg.Dimension)
+	w.UpdateLevel(func(l *world.Level) {
+		l.GeneratorName, l.GeneratorOptions = cfg.LevelType, cfg.GeneratorSettings
+	})
+	if err := w.LoadLevel(); err != nil {
+		log.WithError(err).Errorf("failed to load level of %s", w.Name)
+	}
+
+	level := w.Level()
+	generator, err := generate.New(level.GeneratorName, level.GeneratorOptions)
+	if err != nil {
+		log.WithError(err).Errorf("failed to create generator of %s, use default flat", w.Name)
+		generator, _ = generate.ParseFlatPreset(generate.DefaultFlatPreset)
+	}
+	w.Generator = generator
+	return w
+}
+
+// levelType return level type of Join Game and Respawn, which only changes sky of client.
+func levelType(level *world.Level) string {
+	if strings.EqualFold(level.GeneratorName, generate.NameFlat) {
+		return "flat"
+	}
+	return "default"
+}
+
+// NewJoinGamePacket build Join Game of player in its world.
+func NewJoinGamePacket(p *Player, maxPlayers int) packet.Packet {
+	level := p.world.Level()
+	pkt := packet.NewPacket(0x23)
+	pkt.WriteInt(uint32(p.ID())).
+		WriteUByte(p.GameMode).
+		WriteInt(uint32(p.world.Dimension)).
+		WriteUByte(level.Difficulty).
+		WriteUByte(byte(maxPlayers)).
+		WriteString(levelType(level)).
+		WriteBoolean(true) // reduced debug info
+	return pkt
+}
+
+// NewRespawnPacket build Respawn, client drops its world and chunks if dimension changed.
+func NewRespawnPacket(dimension int32, difficulty, gameMode byte, levelType string) packet.Packet {
+	pkt := packet.NewPacket(0x35)
+	pkt.WriteInt(uint32(dimension)).
+		WriteUByte(difficulty).
+		WriteUByte(gameMode).
+		WriteString(levelType)
+	return pkt
+}
+
+// NewPlayerPositionAndLookPacket build absolute Player Position And Look to move player.
+func NewPlayerPositionAndLookPacket(pl PositionAndLook) packet.Packet {
+	pkt := packet.NewPacket(0x2F)
+	pkt.WriteDouble(pl.X).
+		WriteDouble(pl.Y).
+		WriteDouble(pl.Z).
+		WriteFloat(pl.Yaw).
+		WriteFloat(pl.Pitch).
+		WriteRaw([]byte{0}). // all absolute
+		WriteVarInt(0)
+	return pkt
+}
+
+// SpawnPosition return position above the highest block at spawn point of w,
+// in the nether it is the nearest place to stand under the roof instead.
+func (s *server) SpawnPosition(w *world.World) PositionAndLook {
+	level := w.Level()
+	x, z := int(level.SpawnX), int(level.SpawnZ)
+	y := w.Chunk(int32(x>>4), int32(z>>4)).Height(x&0xF, z&0xF)
+	if w.Dimension == world.DimensionNether {
+		for _, pos := range spiral(world.ChunkPos{X: int32(x), Z: int32(z)}, netherSpawnRadius) {
+			if cy, ok := standY(w, int(pos.X), int(pos.Z)); ok {
+				x, y, z = int(pos.X), cy, int(pos.Z)
+				break
+			}
+		}
+	}
+	return PositionAndLook{X: float64(x) + 0.5, Y: float64(y), Z: float64(z) + 0.5}
+}
+
+// netherSpawnRadius is how far in blocks from spawn point to find a place to stand in the nether.
+const netherSpawnRadius = 32
+
+// standY return the lowest y in column x, z with solid floor and two blocks of air.
+func standY(w *world.World, x, z int) (int, bool) {
+	chunk := w.Chunk(int32(x>>4), int32(z>>4))
+	top := chunk.Height(x&0xF, z&0xF)
+	for y := 1; y < top-2; y++ {
+		floor := chunk.Block(x&0xF, y-1, z&0xF).Block()
+		if floor != nil && floor.Solid && chunk.Block(x&0xF, y, z&0xF) == 0 && chunk.Block(x&0xF, y+1, z&0xF) == 0 {
+			return y, true
+		}
+	}
+	return 0, false
+}
+
+// Transfer move player to pl in world w, the client is told by Respawn
+// and gets chunks of w from scratch. It is called in the loop of player's world,
+// player is handed to the loop of w, only Send can be used after it returns.
+func (s *server) Transfer(p *Player, w *world.World, pl PositionAndLook) {
+	p.stopDigging()
+	// containers are only used in their own world
+	p.CloseWindow(true)
+	s.tracker.Remove(p)
+	s.loop(p.world).removePlayer(p)
+	p.world.RemoveEntity(p)
+
+	level := w.Level()
+	if w.Dimension == p.world.Dimension {
+		// client keeps its world on Respawn to the same dimension,
+		// go through another dimension to drop it
+		other := world.DimensionNether
+		if w.Dimension == world.DimensionNether {
+			other = world.DimensionOverworld
+		}
+		p.Send(NewRespawnPacket(other, level.Difficulty, p.GameMode, levelType(level)))
+	}
+	p.Send(NewRespawnPacket(w.Dimension, level.Difficulty, p.GameMode, levelType(level)))
+
+	p.PL = pl
+	p.hurting.fallDistance = 0
+
+	// tasks of player scheduled from now on run after it arrived
+	p.worldMu.Lock()
+	p.world = w
+	s.loop(w).schedule(func() { s.arrive(p, w) })
+	p.worldMu.Unlock()
+}
+
+// arrive add player transferred into w, it is called in the loop of w.
+// Players gone to another world or quit before it runs are left alone.
+func (s *server) arrive(p *Player, w *world.World) {
+	if p.World() != w || !s.Online(p) {
+		return
+	}
+	t := s.loop(w)
+	t.addPlayer(p)
+	w.AddEntity(p)
+
+	p.resetChunks()
+	p.UpdateChunks()
+	p.Send(NewPlayerPositionAndLookPacket(p.PL))
+	p.Send(NewPlayerAbilitiesPacket(p.abilities))
+	p.SendInventory()
+	p.SendHealth()
+	p.FlushMetadata()
+	p.Send(timePacket(w))
+	for _, pkt := range weatherPackets(w) {
+		p.Send(pkt)
+	}
+	s.tracker.Update(p, t.players)
+}
+
+// worldCommand handle /world [name], it lists worlds without name.
+func (player *Player) worldCommand(args []string) {
+	s := player.server
+	if len(args) == 0 {
+		names := make([]string, 0)
+		for _, w := range s.worlds.All() {
+			names = append(names, w.Name)
+		}
+		player.SendChat(Chat{Text: fmt.Sprintf("当前世界: %s, 所有世界: %s", player.world.Name, strings.Join(names, ", "))})
+		return
+	}
+
+	w := s.worlds.Get(args[0])
+	if w == nil {
+		player.SendError(fmt.Sprintf("未知的世界: %s", args[0]))
+		return
+	}
+	s.Transfer(player, w, s.SpawnPosition(w))
+	player.SendChat(Chat{Text: fmt.Sprintf("已传送到世界 %s", w.Name)})
+}
@@ -0,0 +1,188 @@
+package anvil
+
+import (
+	"fmt"
+
+	"github.com/laushunyu/real/world"
+	"github.com/seebs/nbt"
+)
+
+func nibble(arr nbt.ByteArray, i int) uint8 {
+	if i/2 >= len(arr) {
+		return 0
+	}
+	return uint8(arr[i/2]) >> ((i % 2) * 4) & 0xF
+}
+
+func compoundList(tag nbt.Tag) []nbt.Compound {
+	list, ok := tag.(nbt.List)
+	if !ok {
+		return nil
+	}
+	compounds, _ := list.GetCompoundList()
+	return compounds
+}
+
+// DecodeChunk convert nbt of 1.12 chunk to world chunk.
+func DecodeChunk(root nbt.Compound) (*world.Chunk, error) {
+	level, ok := root["Level"].(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("chunk has no Level")
+	}
+	x, okX := level["xPos"].(nbt.Int)
+	z, okZ := level["zPos"].(nbt.Int)
+	if !okX || !okZ {
+		return nil, fmt.Errorf("chunk has no xPos or zPos")
+	}
+	chunk := world.NewChunk(int32(x), int32(z))
+
+	for _, tag := range compoundList(level["Sections"]) {
+		y, _ := tag["Y"].(nbt.Byte)
+		if y < 0 || int(y) >= world.SectionCount {
+			continue
+		}
+		section, err := decodeSection(tag)
+		if err != nil {
+			return nil, fmt.Errorf("section %d of chunk (%d, %d): %w", y, x, z, err)
+		}
+		chunk.SetSection(int(y), section)
+	}
+
+	if biomes, ok := level["Biomes"].(nbt.ByteArray); ok && len(biomes) == 256 {
+		var arr [256]byte
+		for i, b := range biomes {
+			arr[i] = byte(b)
+		}
+		chunk.SetBiomes(arr)
+	}
+
+	for _, tag := range compoundList(level["TileEntities"]) {
+		bx, _ := tag["x"].(nbt.Int)
+		by, _ := tag["y"].(nbt.Int)
+		bz, _ := tag["z"].(nbt.Int)
+		chunk.SetBlockEntity(int(bx&0xF), int(by), int(bz&0xF), tag)
+	}
+
+	chunk.SetEntityTags(compoundList(level["Entities"]))
+	return chunk, nil
+}
+
+func decodeSection(tag nbt.Compound) (*world.Section, error) {
+	blocks, ok := tag["Blocks"].(nbt.ByteArray)
+	if !ok || len(blocks) != 4096 {
+		return nil, fmt.Errorf("invalid Blocks")
+	}
+	add, _ := tag["Add"].(nbt.ByteArray)
+	data, _ := tag["Data"].(nbt.ByteArray)
+
+	section := world.NewSection()
+	for i := range blocks {
+		id := uint16(uint8(blocks[i])) | uint16(nibble(add, i))<<8
+		if id == 0 {
+			continue
+		}
+		section.SetBlock(i&0xF, i>>8, (i>>4)&0xF, world.NewBlockState(id, nibble(data, i)))
+	}
+
+	if light, ok := tag["BlockLight"].(nbt.ByteArray); ok && len(light) == len(section.BlockLight) {
+		for i, b := range light {
+			section.BlockLight[i] = byte(b)
+		}
+	}
+	if light, ok := tag["SkyLight"].(nbt.ByteArray); ok && len(light) == len(section.SkyLight) {
+		for i, b := range light {
+			section.SkyLight[i] = byte(b)
+		}
+	}
+	return section, nil
+}
+
+// DataVersion of chunks saved by 1.12.2
+const DataVersion = 1343
+
+func setNibble(arr nbt.ByteArray, i int, v uint8) {
+	shift := uint((i % 2) * 4)
+	arr[i/2] = int8(uint8(arr[i/2])&^(0xF<<shift) | v<<shift)
+}
+
+// EncodeChunk convert world chunk to nbt of 1.12 chunk.
+func EncodeChunk(chunk *world.Chunk) nbt.Compound {
+	var sections []nbt.Compound
+	chunk.EachSection(func(y int, section *world.Section) {
+		sections = append(sections, encodeSection(y, section))
+	})
+
+	biomes := chunk.Biomes()
+	biomeArr := make(nbt.ByteArray, len(biomes))
+	for i, b := range biomes {
+		biomeArr[i] = int8(b)
+	}
+
+	heightMap := make(nbt.IntArray, 256)
+	for i := range heightMap {
+		heightMap[i] = nbt.Int(chunk.Height(i&0xF, i>>4))
+	}
+
+	entities := chunk.EntityTags()
+	if entities == nil {
+		entities = []nbt.Compound{}
+	}
+
+	level := nbt.Compound{
+		"xPos":             nbt.Int(chunk.X),
+		"zPos":             nbt.Int(chunk.Z),
+		"LastUpdate":       nbt.Long(0),
+		"InhabitedTime":    nbt.Long(0),
+		"TerrainPopulated": nbt.Byte(1),
+		"LightPopulated":   nbt.Byte(1),
+		"V":                nbt.Byte(1),
+		"Sections":         nbt.MakeCompoundList(sections),
+		"Biomes":           biomeArr,
+		"HeightMap":        heightMap,
+		"TileEntities":     nbt.MakeCompoundList(chunk.BlockEntities()),
+		"Entities":         nbt.MakeCompoundList(entities),
+	}
+	return nbt.Compound{
+		"DataVersion": nbt.Int(DataVersion),
+		"Level":       level,
+	}
+}
+
+func encodeSection(y int, section *world.Section) nbt.Compound {
+	blocks := make(nbt.ByteArray, 4096)
+	data := make(nbt.ByteArray, 2048)
+	var add nbt.ByteArray
+	for i := range blocks {
+		state := section.Block(i&0xF, i>>8, (i>>4)&0xF)
+		id := state.ID()
+		blocks[i] = int8(uint8(id))
+		setNibble(data, i, state.Meta())
+		if id > 0xFF {
+			if add == nil {
+				add = make(nbt.ByteArray, 2048)
+			}
+			setNibble(add, i, uint8(id>>8))
+		}
+	}
+
+	blockLight := make(nbt.ByteArray, len(section.BlockLight))
+	for i, b := range section.BlockLight {
+		blockLight[i] = int8(b)
+	}
+	skyLight := make(nbt.ByteArray, len(section.SkyLight))
+	for i, b := range section.SkyLight {
+		skyLight[i] = int8(b)
+	}
+
+	tag := nbt.Compound{
+		"Y":          nbt.Byte(y),
+		"Blocks":     blocks,
+		"Data":       data,
+		"BlockLight": blockLight,
+		"SkyLight":   skyLight,
+	}
+	if add != nil {
+		tag["Add"] = add
+	}
+	return tag
+}
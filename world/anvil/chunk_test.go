@@ -0,0 +1,197 @@
+package anvil
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/laushunyu/real/world"
+	"github.com/seebs/nbt"
+)
+
+func TestDecodeChunk(t *testing.T) {
+	blocks := make(nbt.ByteArray, 4096)
+	add := make(nbt.ByteArray, 2048)
+	data := make(nbt.ByteArray, 2048)
+	light := make(nbt.ByteArray, 2048)
+	b := func(v uint8) int8 { return int8(v) }
+	// block 0 is stone:1, block 1 is id 0x1A3 with Add and meta 15,
+	// nibbles of even blocks are low
+	blocks[0], blocks[1] = 1, b(0xA3)
+	add[0], data[0] = 0x10, b(0xF1)
+	light[0] = 0x3E
+
+	biomes := make(nbt.ByteArray, 256)
+	biomes[17] = 2
+	root := nbt.Compound{"Level": nbt.Compound{
+		"xPos": nbt.Int(-5),
+		"zPos": nbt.Int(7),
+		"Sections": nbt.MakeCompoundList([]nbt.Compound{
+			{"Y": nbt.Byte(2), "Blocks": blocks, "Add": add, "Data": data, "BlockLight": light, "SkyLight": light},
+			// sections out of chunk are skipped
+			{"Y": nbt.Byte(16), "Blocks": blocks},
+		}),
+		"Biomes": biomes,
+		"TileEntities": nbt.MakeCompoundList([]nbt.Compound{
+			{"id": nbt.String("minecraft:chest"), "x": nbt.Int(-80), "y": nbt.Int(32), "z": nbt.Int(112)},
+		}),
+	}}
+
+	chunk, err := DecodeChunk(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chunk.X != -5 || chunk.Z != 7 {
+		t.Errorf("chunk at (%d, %d)", chunk.X, chunk.Z)
+	}
+	for _, c := range []struct {
+		x, y, z int
+		state   world.BlockState
+	}{
+		{0, 32, 0, world.NewBlockState(1, 1)},
+		{1, 32, 0, world.NewBlockState(0x1A3, 15)},
+		{2, 32, 0, world.Air},
+		{0, 31, 0, world.Air},
+		{0, 48, 0, world.Air},
+	} {
+		if got := chunk.Block(c.x, c.y, c.z); got != c.state {
+			t.Errorf("block at (%d, %d, %d) is %v, want %v", c.x, c.y, c.z, got, c.state)
+		}
+	}
+	if chunk.BlockLight(0, 32, 0) != 0xE || chunk.BlockLight(1, 32, 0) != 0x3 || chunk.SkyLight(1, 32, 0) != 0x3 {
+		t.Errorf("light is %d %d %d", chunk.BlockLight(0, 32, 0), chunk.BlockLight(1, 32, 0), chunk.SkyLight(1, 32, 0))
+	}
+	if chunk.Biome(1, 1) != 2 {
+		t.Errorf("biome is %d", chunk.Biome(1, 1))
+	}
+	if tag := chunk.BlockEntity(0, 32, 0); tag["id"] != nbt.String("minecraft:chest") {
+		t.Errorf("block entity is %v", tag)
+	}
+}
+
+func TestDecodeChunkInvalid(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		root nbt.Compound
+	}{
+		{"no level", nbt.Compound{}},
+		{"no position", nbt.Compound{"Level": nbt.Compound{"xPos": nbt.Int(0)}}},
+		{"short blocks", nbt.Compound{"Level": nbt.Compound{
+			"xPos": nbt.Int(0), "zPos": nbt.Int(0),
+			"Sections": nbt.MakeCompoundList([]nbt.Compound{{"Y": nbt.Byte(0), "Blocks": make(nbt.ByteArray, 16)}}),
+		}}},
+	} {
+		if _, err := DecodeChunk(c.root); err == nil {
+			t.Errorf("%s: decoded", c.name)
+		}
+	}
+}
+
+// sameChunk report the first difference between chunks a and b, empty if none.
+func sameChunk(a, b *world.Chunk) string {
+	if a.X != b.X || a.Z != b.Z {
+		return "position"
+	}
+	if a.Biomes() != b.Biomes() {
+		return "biomes"
+	}
+	for y := 0; y < world.SectionCount*16; y++ {
+		for z := 0; z < 16; z++ {
+			for x := 0; x < 16; x++ {
+				switch {
+				case a.Block(x, y, z) != b.Block(x, y, z):
+					return fmt.Sprintf("block at (%d, %d, %d)", x, y, z)
+				case a.BlockLight(x, y, z) != b.BlockLight(x, y, z):
+					return fmt.Sprintf("block light at (%d, %d, %d)", x, y, z)
+				case a.SkyLight(x, y, z) != b.SkyLight(x, y, z):
+					return fmt.Sprintf("sky light at (%d, %d, %d)", x, y, z)
+				}
+			}
+		}
+	}
+	if !sameTags(a.BlockEntities(), b.BlockEntities()) {
+		return "block entities"
+	}
+	if !sameTags(a.EntityTags(), b.EntityTags()) {
+		return "entities"
+	}
+	return ""
+}
+
+// sameTags report whether a and b have the same compounds, nil is the same as empty.
+func sameTags(a, b []nbt.Compound) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
+
+// testChunks return chunks to be saved and loaded back.
+func testChunks() map[string]*world.Chunk {
+	empty := world.NewChunk(0, 0)
+
+	flat := world.NewChunk(-1, 31)
+	for z := 0; z < 16; z++ {
+		for x := 0; x < 16; x++ {
+			flat.SetBlock(x, 0, z, world.NewBlockState(7, 0))
+			flat.SetBlock(x, 1, z, world.NewBlockState(3, 0))
+			flat.SetBlock(x, 2, z, world.NewBlockState(2, 0))
+			flat.SetBiome(x, z, 1)
+		}
+	}
+
+	mixed := world.NewChunk(100, -100)
+	for i := 0; i < 16*256*16; i += 7 {
+		x, y, z := i&0xF, i>>8, i>>4&0xF
+		// ids above 255 are saved in Add
+		mixed.SetBlock(x, y, z, world.NewBlockState(uint16(1+i%500), uint8(i%16)))
+		mixed.SetLight(world.LightBlock, x, y, z, uint8(i%16))
+		mixed.SetLight(world.LightSky, x, y, z, uint8(15-i%16))
+		mixed.SetBiome(x, z, byte(i))
+	}
+	mixed.SetBlockEntity(3, 64, 4, nbt.Compound{
+		"id": nbt.String("minecraft:furnace"), "x": nbt.Int(1603), "y": nbt.Int(64), "z": nbt.Int(-1596),
+		"BurnTime": nbt.Short(20),
+	})
+	mixed.SetEntityTags([]nbt.Compound{{"id": nbt.String("minecraft:pig"), "Health": nbt.Float(10)}})
+
+	return map[string]*world.Chunk{"empty": empty, "flat": flat, "mixed": mixed}
+}
+
+func TestChunkRoundTrip(t *testing.T) {
+	for name, chunk := range testChunks() {
+		got, err := DecodeChunk(EncodeChunk(chunk))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if diff := sameChunk(chunk, got); diff != "" {
+			t.Errorf("%s: %s differs after encoded and decoded", name, diff)
+		}
+	}
+}
+
+func TestStorageRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	chunks := testChunks()
+	s := NewStorage(dir)
+	for name, chunk := range chunks {
+		if err := s.SaveChunk(chunk); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s = NewStorage(dir)
+	defer s.Close()
+	for name, chunk := range chunks {
+		got, err := s.LoadChunk(chunk.X, chunk.Z)
+		if err != nil || got == nil {
+			t.Fatalf("%s: loaded %v with %v", name, got, err)
+		}
+		if diff := sameChunk(chunk, got); diff != "" {
+			t.Errorf("%s: %s differs after saved and loaded", name, diff)
+		}
+	}
+	if got, err := s.LoadChunk(1, 0); got != nil || err != nil {
+		t.Errorf("chunk not saved loaded %v with %v", got, err)
+	}
+}
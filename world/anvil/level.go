@@ -0,0 +1,175 @@
+package anvil
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/laushunyu/real/world"
+	"github.com/seebs/nbt"
+)
+
+// version of level.dat format since 1.0
+const levelVersion = 19133
+
+func boolByte(b bool) nbt.Byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// DecodeLevel convert nbt of level.dat to level.
+func DecodeLevel(root nbt.Compound) (*world.Level, error) {
+	data, ok := root["Data"].(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("level has no Data")
+	}
+
+	name, _ := data["LevelName"].(nbt.String)
+	l := world.NewLevel(string(name))
+	if seed, ok := data["RandomSeed"].(nbt.Long); ok {
+		l.Seed = int64(seed)
+	}
+	if x, ok := data["SpawnX"].(nbt.Int); ok {
+		l.SpawnX = int32(x)
+	}
+	if y, ok := data["SpawnY"].(nbt.Int); ok {
+		l.SpawnY = int32(y)
+	}
+	if z, ok := data["SpawnZ"].(nbt.Int); ok {
+		l.SpawnZ = int32(z)
+	}
+
+	t, _ := data["Time"].(nbt.Long)
+	l.Time = int64(t)
+	dayTime, ok := data["DayTime"].(nbt.Long)
+	if !ok {
+		// old levels have no DayTime
+		dayTime = t
+	}
+	l.DayTime = int64(dayTime)
+
+	raining, _ := data["raining"].(nbt.Byte)
+	rainTime, _ := data["rainTime"].(nbt.Int)
+	thundering, _ := data["thundering"].(nbt.Byte)
+	thunderTime, _ := data["thunderTime"].(nbt.Int)
+	clearWeatherTime, _ := data["clearWeatherTime"].(nbt.Int)
+	l.Raining, l.RainTime = raining != 0, int32(rainTime)
+	l.Thundering, l.ThunderTime = thundering != 0, int32(thunderTime)
+	l.ClearWeatherTime = int32(clearWeatherTime)
+
+	if gameType, ok := data["GameType"].(nbt.Int); ok {
+		l.GameType = int32(gameType)
+	}
+	if difficulty, ok := data["Difficulty"].(nbt.Byte); ok {
+		l.Difficulty = byte(difficulty)
+	}
+	hardcore, _ := data["hardcore"].(nbt.Byte)
+	l.Hardcore = hardcore != 0
+
+	if generator, ok := data["generatorName"].(nbt.String); ok {
+		l.GeneratorName = string(generator)
+	}
+	options, _ := data["generatorOptions"].(nbt.String)
+	l.GeneratorOptions = string(options)
+
+	if rules, ok := data["GameRules"].(nbt.Compound); ok {
+		for rule, tag := range rules {
+			if value, ok := tag.(nbt.String); ok {
+				l.GameRules[string(rule)] = string(value)
+			}
+		}
+	}
+
+	lastPlayed, _ := data["LastPlayed"].(nbt.Long)
+	l.LastPlayed = int64(lastPlayed)
+	return l, nil
+}
+
+// EncodeLevel convert level to nbt of level.dat.
+func EncodeLevel(l *world.Level) nbt.Compound {
+	rules := make(nbt.Compound, len(l.GameRules))
+	for rule, value := range l.GameRules {
+		rules[nbt.String(rule)] = nbt.String(value)
+	}
+
+	data := nbt.Compound{
+		"LevelName":        nbt.String(l.Name),
+		"RandomSeed":       nbt.Long(l.Seed),
+		"SpawnX":           nbt.Int(l.SpawnX),
+		"SpawnY":           nbt.Int(l.SpawnY),
+		"SpawnZ":           nbt.Int(l.SpawnZ),
+		"Time":             nbt.Long(l.Time),
+		"DayTime":          nbt.Long(l.DayTime),
+		"raining":          boolByte(l.Raining),
+		"rainTime":         nbt.Int(l.RainTime),
+		"thundering":       boolByte(l.Thundering),
+		"thunderTime":      nbt.Int(l.ThunderTime),
+		"clearWeatherTime": nbt.Int(l.ClearWeatherTime),
+		"GameType":         nbt.Int(l.GameType),
+		"Difficulty":       nbt.Byte(l.Difficulty),
+		"hardcore":         boolByte(l.Hardcore),
+		"generatorName":    nbt.String(l.GeneratorName),
+		"generatorOptions": nbt.String(l.GeneratorOptions),
+		"GameRules":        rules,
+		"LastPlayed":       nbt.Long(l.LastPlayed),
+		"MapFeatures":      nbt.Byte(1),
+		"allowCommands":    nbt.Byte(1),
+		"initialized":      nbt.Byte(1),
+		"version":          nbt.Int(levelVersion),
+		"DataVersion":      nbt.Int(DataVersion),
+		"Version": nbt.Compound{
+			"Id":       nbt.Int(DataVersion),
+			"Name":     nbt.String("1.12.2"),
+			"Snapshot": nbt.Byte(0),
+		},
+	}
+	return nbt.Compound{"Data": data}
+}
+
+// ReadLevel read gzipped level.dat at path.
+func ReadLevel(path string) (*world.Level, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tag, _, err := nbt.LoadCompressed(f)
+	if err != nil {
+		return nil, fmt.Errorf("read level %s: %w", path, err)
+	}
+	root, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("root of level %s is %s but not compound", path, tag.Type())
+	}
+	return DecodeLevel(root)
+}
+
+// WriteLevel write gzipped level.dat to path like vanilla does:
+// new level is written to <path>_new first, then the old one is moved to <path>_old,
+// so there is always a complete level.dat or level.dat_old if crashed.
+func WriteLevel(path string, l *world.Level) error {
+	newPath, oldPath := path+"_new", path+"_old"
+
+	f, err := os.Create(newPath)
+	if err != nil {
+		return err
+	}
+	if err := nbt.StoreCompressed(f, EncodeLevel(l), ""); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(path, oldPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Rename(newPath, path)
+}
@@ -0,0 +1,216 @@
+package anvil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/laushunyu/real/item"
+	"github.com/laushunyu/real/stream"
+	"github.com/laushunyu/real/world"
+	"github.com/seebs/nbt"
+)
+
+// DecodeItem convert item in nbt like {id:"minecraft:stone",Count:1b,Damage:0s} to slot,
+// unknown items are empty.
+func DecodeItem(tag nbt.Compound) stream.Slot {
+	var it *item.Item
+	switch id := tag["id"].(type) {
+	case nbt.String:
+		it = item.ByName(string(id))
+	case nbt.Short:
+		// numeric ids before 1.8
+		it = item.ByID(uint16(id))
+	}
+	count, _ := tag["Count"].(nbt.Byte)
+	if it == nil || count <= 0 {
+		return stream.EmptySlot
+	}
+	damage, _ := tag["Damage"].(nbt.Short)
+	slot := stream.Slot{ID: int16(it.ID), Count: byte(count), Damage: int16(damage)}
+	if t, ok := tag["tag"].(nbt.Compound); ok {
+		slot.NBT = t
+	}
+	return slot
+}
+
+// EncodeItem convert slot to item in nbt.
+func EncodeItem(slot stream.Slot) nbt.Compound {
+	tag := nbt.Compound{
+		"Count":  nbt.Byte(slot.Count),
+		"Damage": nbt.Short(slot.Damage),
+	}
+	if it := item.ByID(uint16(slot.ID)); it != nil {
+		tag["id"] = nbt.String(it.Name)
+	}
+	if slot.NBT != nil {
+		tag["tag"] = slot.NBT
+	}
+	return tag
+}
+
+// DecodePlayer convert nbt of playerdata to player.
+func DecodePlayer(id uuid.UUID, root nbt.Compound) *world.PlayerData {
+	data := &world.PlayerData{
+		UUID:      id,
+		Inventory: make(map[int8]stream.Slot),
+		// defaults of a new player if not saved
+		Health:     20,
+		Food:       20,
+		Saturation: 5,
+		Air:        300,
+	}
+
+	if list, ok := root["Pos"].(nbt.List); ok {
+		if pos, ok := list.GetDoubleList(); ok && len(pos) == 3 {
+			data.X, data.Y, data.Z = float64(pos[0]), float64(pos[1]), float64(pos[2])
+		}
+	}
+	if list, ok := root["Rotation"].(nbt.List); ok {
+		if rotation, ok := list.GetFloatList(); ok && len(rotation) == 2 {
+			data.Yaw, data.Pitch = float32(rotation[0]), float32(rotation[1])
+		}
+	}
+	onGround, _ := root["OnGround"].(nbt.Byte)
+	data.OnGround = onGround != 0
+
+	dimension, _ := root["Dimension"].(nbt.Int)
+	data.Dimension = int32(dimension)
+	name, _ := root["WorldName"].(nbt.String)
+	data.World = string(name)
+
+	gameType, _ := root["playerGameType"].(nbt.Int)
+	data.GameMode = int32(gameType)
+	selected, _ := root["SelectedItemSlot"].(nbt.Int)
+	data.SelectedSlot = int32(selected)
+
+	if health, ok := root["Health"].(nbt.Float); ok {
+		data.Health = float32(health)
+	}
+	if food, ok := root["foodLevel"].(nbt.Int); ok {
+		data.Food = int32(food)
+	}
+	if saturation, ok := root["foodSaturationLevel"].(nbt.Float); ok {
+		data.Saturation = float32(saturation)
+	}
+	exhaustion, _ := root["foodExhaustionLevel"].(nbt.Float)
+	data.Exhaustion = float32(exhaustion)
+	if air, ok := root["Air"].(nbt.Short); ok {
+		data.Air = int16(air)
+	}
+	fire, _ := root["Fire"].(nbt.Short)
+	data.Fire = int16(fire)
+	fallDistance, _ := root["FallDistance"].(nbt.Float)
+	data.FallDistance = float32(fallDistance)
+
+	if book, ok := root["recipeBook"].(nbt.Compound); ok {
+		open, _ := book["isGuiOpen"].(nbt.Byte)
+		filtering, _ := book["isFilteringCraftable"].(nbt.Byte)
+		data.RecipeBookOpen, data.RecipeBookFiltering = open != 0, filtering != 0
+	}
+
+	for _, tag := range compoundList(root["Inventory"]) {
+		slot, ok := tag["Slot"].(nbt.Byte)
+		if !ok {
+			continue
+		}
+		if it := DecodeItem(tag); !it.Empty() {
+			data.Inventory[int8(slot)] = it
+		}
+	}
+	return data
+}
+
+// EncodePlayer convert player to nbt of playerdata.
+func EncodePlayer(data *world.PlayerData) nbt.Compound {
+	inventory := make([]nbt.Compound, 0, len(data.Inventory))
+	for slot, it := range data.Inventory {
+		if it.Empty() {
+			continue
+		}
+		tag := EncodeItem(it)
+		tag["Slot"] = nbt.Byte(slot)
+		inventory = append(inventory, tag)
+	}
+
+	return nbt.Compound{
+		"UUIDMost":            nbt.Long(binaryLong(data.UUID[:8])),
+		"UUIDLeast":           nbt.Long(binaryLong(data.UUID[8:])),
+		"Pos":                 nbt.MakeDoubleList([]nbt.Double{nbt.Double(data.X), nbt.Double(data.Y), nbt.Double(data.Z)}),
+		"Rotation":            nbt.MakeFloatList([]nbt.Float{nbt.Float(data.Yaw), nbt.Float(data.Pitch)}),
+		"OnGround":            boolByte(data.OnGround),
+		"Dimension":           nbt.Int(data.Dimension),
+		"WorldName":           nbt.String(data.World),
+		"playerGameType":      nbt.Int(data.GameMode),
+		"SelectedItemSlot":    nbt.Int(data.SelectedSlot),
+		"Inventory":           nbt.MakeCompoundList(inventory),
+		"Health":              nbt.Float(data.Health),
+		"foodLevel":           nbt.Int(data.Food),
+		"foodSaturationLevel": nbt.Float(data.Saturation),
+		"foodExhaustionLevel": nbt.Float(data.Exhaustion),
+		"Air":                 nbt.Short(data.Air),
+		"Fire":                nbt.Short(data.Fire),
+		"FallDistance":        nbt.Float(data.FallDistance),
+		"recipeBook": nbt.Compound{
+			"isGuiOpen":            boolByte(data.RecipeBookOpen),
+			"isFilteringCraftable": boolByte(data.RecipeBookFiltering),
+		},
+		"DataVersion": nbt.Int(DataVersion),
+	}
+}
+
+func binaryLong(b []byte) int64 {
+	var v int64
+	for _, c := range b {
+		v = v<<8 | int64(c)
+	}
+	return v
+}
+
+func (s *Storage) playerPath(id uuid.UUID) string {
+	return filepath.Join(s.dir, "playerdata", id.String()+".dat")
+}
+
+// LoadPlayer read gzipped playerdata/<uuid>.dat.
+func (s *Storage) LoadPlayer(id uuid.UUID) (*world.PlayerData, error) {
+	f, err := os.Open(s.playerPath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	tag, _, err := nbt.LoadCompressed(f)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("root of player is %s but not compound", tag.Type())
+	}
+	return DecodePlayer(id, root), nil
+}
+
+// SavePlayer write playerdata/<uuid>.dat through a temp file, so it is never half written.
+func (s *Storage) SavePlayer(data *world.PlayerData) error {
+	path := s.playerPath(data.UUID)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := nbt.StoreCompressed(f, EncodePlayer(data), ""); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,265 @@
+package anvil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/seebs/nbt"
+)
+
+const (
+	sectorSize = 4096
+	// chunks in a region are 32x32
+	regionChunks = 32 * 32
+)
+
+// compression types of chunk in region
+const (
+	CompressionGzip = 1
+	CompressionZlib = 2
+	CompressionNone = 3
+)
+
+var ErrChunkNotFound = errors.New("chunk not found in region")
+
+// Region is a r.<x>.<z>.mca file storing 32x32 chunks.
+type Region struct {
+	mu sync.Mutex
+	f  *os.File
+
+	// offset in sectors << 8 | sector count
+	locations [regionChunks]uint32
+	// last modification time in seconds
+	timestamps [regionChunks]uint32
+	// which sectors of file are in use, the first two are header
+	used []bool
+}
+
+// RegionFileName return name of region file which chunk x, z belongs to.
+func RegionFileName(chunkX, chunkZ int32) string {
+	return fmt.Sprintf("r.%d.%d.mca", chunkX>>5, chunkZ>>5)
+}
+
+func chunkIndex(chunkX, chunkZ int32) int {
+	return int(chunkX&31) + int(chunkZ&31)*32
+}
+
+// OpenRegion open region file at path and read its header,
+// the file is created with an empty header if create is true and it not exists.
+func OpenRegion(path string, create bool) (*Region, error) {
+	flag := os.O_RDWR
+	if create {
+		flag |= os.O_CREATE
+	}
+	f, err := os.OpenFile(path, flag, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r := &Region{f: f}
+
+	header := make([]byte, 2*sectorSize)
+	if info.Size() == 0 {
+		if _, err := f.WriteAt(header, 0); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("write header of region %s: %w", path, err)
+		}
+	} else if _, err := io.ReadFull(f, header); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("read header of region %s: %w", path, err)
+	}
+
+	sectors := int((info.Size() + sectorSize - 1) / sectorSize)
+	if sectors < 2 {
+		sectors = 2
+	}
+	r.used = make([]bool, sectors)
+	r.used[0], r.used[1] = true, true
+	for i := 0; i < regionChunks; i++ {
+		r.locations[i] = binary.BigEndian.Uint32(header[i*4:])
+		r.timestamps[i] = binary.BigEndian.Uint32(header[sectorSize+i*4:])
+
+		offset, count := int(r.locations[i]>>8), int(r.locations[i]&0xFF)
+		if offset < 2 || count == 0 || offset+count > sectors {
+			// broken location, treat the chunk as not saved
+			r.locations[i] = 0
+			continue
+		}
+		for j := offset; j < offset+count; j++ {
+			r.used[j] = true
+		}
+	}
+	return r, nil
+}
+
+func (r *Region) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Close()
+}
+
+// HasChunk report whether chunk x, z is stored in region.
+func (r *Region) HasChunk(chunkX, chunkZ int32) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.locations[chunkIndex(chunkX, chunkZ)] != 0
+}
+
+// Timestamp return when chunk x, z was saved in unix seconds.
+func (r *Region) Timestamp(chunkX, chunkZ int32) uint32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.timestamps[chunkIndex(chunkX, chunkZ)]
+}
+
+// ReadChunk read and decompress nbt of chunk x, z, ErrChunkNotFound if not stored.
+func (r *Region) ReadChunk(chunkX, chunkZ int32) (nbt.Compound, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	location := r.locations[chunkIndex(chunkX, chunkZ)]
+	if location == 0 {
+		return nil, ErrChunkNotFound
+	}
+	offset, count := int64(location>>8)*sectorSize, int(location&0xFF)*sectorSize
+
+	raw := make([]byte, count)
+	if _, err := r.f.ReadAt(raw, offset); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if len(raw) < 5 {
+		return nil, fmt.Errorf("no room for header of chunk (%d, %d)", chunkX, chunkZ)
+	}
+	length := int(binary.BigEndian.Uint32(raw))
+	if length <= 1 || length+4 > len(raw) {
+		return nil, fmt.Errorf("invalid length %d of chunk (%d, %d)", length, chunkX, chunkZ)
+	}
+	compression, data := raw[4], raw[5:4+length]
+
+	var rd io.Reader
+	switch compression {
+	case CompressionGzip:
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		rd = gr
+	case CompressionZlib:
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		rd = zr
+	case CompressionNone:
+		rd = bytes.NewReader(data)
+	default:
+		return nil, fmt.Errorf("unknown compression %d of chunk (%d, %d)", compression, chunkX, chunkZ)
+	}
+
+	tag, _, err := nbt.LoadUncompressed(rd)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("root of chunk (%d, %d) is %s but not compound", chunkX, chunkZ, tag.Type())
+	}
+	return root, nil
+}
+
+// WriteChunk compress nbt of chunk x, z with zlib and write it into region.
+// Data is written into free sectors before the header points to it,
+// so a crash while writing keeps the old chunk.
+func (r *Region) WriteChunk(chunkX, chunkZ int32, root nbt.Compound) error {
+	buf := bytes.NewBuffer(make([]byte, 5, sectorSize))
+	zw := zlib.NewWriter(buf)
+	if err := nbt.StoreUncompressed(zw, root, ""); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	binary.BigEndian.PutUint32(data, uint32(len(data)-4))
+	data[4] = CompressionZlib
+
+	count := (len(data) + sectorSize - 1) / sectorSize
+	if count > 0xFF {
+		return fmt.Errorf("chunk (%d, %d) is too large: %d bytes", chunkX, chunkZ, len(data))
+	}
+	// pad to whole sectors
+	data = append(data, make([]byte, count*sectorSize-len(data))...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := chunkIndex(chunkX, chunkZ)
+	oldOffset, oldCount := int(r.locations[i]>>8), int(r.locations[i]&0xFF)
+
+	// old sectors are still in use here, they are freed after header updated
+	offset := r.allocate(count)
+	if _, err := r.f.WriteAt(data, int64(offset)*sectorSize); err != nil {
+		return err
+	}
+	for j := offset; j < offset+count; j++ {
+		r.used[j] = true
+	}
+
+	location, timestamp := uint32(offset)<<8|uint32(count), uint32(time.Now().Unix())
+	var entry [4]byte
+	binary.BigEndian.PutUint32(entry[:], location)
+	if _, err := r.f.WriteAt(entry[:], int64(i*4)); err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint32(entry[:], timestamp)
+	if _, err := r.f.WriteAt(entry[:], int64(sectorSize+i*4)); err != nil {
+		return err
+	}
+	r.locations[i], r.timestamps[i] = location, timestamp
+
+	// old sectors can be reused by next write
+	for j := oldOffset; oldOffset != 0 && j < oldOffset+oldCount; j++ {
+		r.used[j] = false
+	}
+	return nil
+}
+
+// allocate return offset of the first free run of count sectors,
+// sectors at the end of file are appended if no run is large enough.
+func (r *Region) allocate(count int) int {
+	run := 0
+	for i := 2; i < len(r.used); i++ {
+		if r.used[i] {
+			run = 0
+			continue
+		}
+		run++
+		if run == count {
+			return i - count + 1
+		}
+	}
+	offset := len(r.used) - run
+	r.used = append(r.used, make([]bool, count-run)...)
+	return offset
+}
+
+// Sync commit written chunks to disk.
+func (r *Region) Sync() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Sync()
+}
@@ -0,0 +1,233 @@
+package anvil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/seebs/nbt"
+)
+
+// chunkData return chunk stored in sectors: length, compression and compressed nbt of root.
+func chunkData(t *testing.T, compression byte, root nbt.Compound) []byte {
+	var body bytes.Buffer
+	switch compression {
+	case CompressionGzip:
+		gw := gzip.NewWriter(&body)
+		if err := nbt.StoreUncompressed(gw, root, ""); err != nil {
+			t.Fatal(err)
+		}
+		gw.Close()
+	case CompressionZlib:
+		zw := zlib.NewWriter(&body)
+		if err := nbt.StoreUncompressed(zw, root, ""); err != nil {
+			t.Fatal(err)
+		}
+		zw.Close()
+	default:
+		if err := nbt.StoreUncompressed(&body, root, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data := make([]byte, 5, 5+body.Len())
+	binary.BigEndian.PutUint32(data, uint32(body.Len()+1))
+	data[4] = compression
+	return append(data, body.Bytes()...)
+}
+
+// writeRegion write a region file of sectors, data of chunk at index i is put at sector
+// offset of locations[i], sectors not covered are zero.
+func writeRegion(t *testing.T, sectors int, locations map[int]uint32, data map[int][]byte) string {
+	file := make([]byte, sectors*sectorSize)
+	for i, location := range locations {
+		binary.BigEndian.PutUint32(file[i*4:], location)
+		if d, ok := data[i]; ok {
+			copy(file[int(location>>8)*sectorSize:], d)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "r.0.0.mca")
+	if err := os.WriteFile(path, file, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadChunkCompression(t *testing.T) {
+	root := nbt.Compound{"Level": nbt.Compound{"xPos": nbt.Int(3), "zPos": nbt.Int(-2)}}
+	for _, compression := range []byte{CompressionGzip, CompressionZlib, CompressionNone} {
+		i := chunkIndex(3, -2)
+		path := writeRegion(t, 3, map[int]uint32{i: 2<<8 | 1}, map[int][]byte{i: chunkData(t, compression, root)})
+		r, err := OpenRegion(path, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := r.ReadChunk(3, -2)
+		r.Close()
+		if err != nil {
+			t.Fatalf("compression %d: %v", compression, err)
+		}
+		level, _ := got["Level"].(nbt.Compound)
+		if level["xPos"] != nbt.Int(3) || level["zPos"] != nbt.Int(-2) {
+			t.Errorf("compression %d: read %v", compression, got)
+		}
+	}
+}
+
+func TestRegionBrokenLocations(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		location uint32
+	}{
+		{"in header", 1<<8 | 1},
+		{"no sectors", 2 << 8},
+		{"past end of file", 2<<8 | 2},
+		{"far past end of file", 100<<8 | 1},
+	} {
+		path := writeRegion(t, 3, map[int]uint32{0: c.location}, nil)
+		r, err := OpenRegion(path, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.HasChunk(0, 0) {
+			t.Errorf("%s: chunk is stored", c.name)
+		}
+		if _, err := r.ReadChunk(0, 0); !errors.Is(err, ErrChunkNotFound) {
+			t.Errorf("%s: read with %v", c.name, err)
+		}
+		r.Close()
+	}
+}
+
+func TestReadChunkInvalid(t *testing.T) {
+	valid := chunkData(t, CompressionZlib, nbt.Compound{})
+	for _, c := range []struct {
+		name string
+		data []byte
+	}{
+		{"zero length", []byte{0, 0, 0, 0, CompressionZlib}},
+		{"only compression", []byte{0, 0, 0, 1, CompressionZlib}},
+		{"longer than sectors", append([]byte{0, 0, 0x10, 1}, valid[4:]...)},
+		{"unknown compression", append(append([]byte{}, valid[:4]...), append([]byte{9}, valid[5:]...)...)},
+		{"bad zlib", []byte{0, 0, 0, 4, CompressionZlib, 1, 2, 3}},
+	} {
+		path := writeRegion(t, 3, map[int]uint32{0: 2<<8 | 1}, map[int][]byte{0: c.data})
+		r, err := OpenRegion(path, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.ReadChunk(0, 0); err == nil || errors.Is(err, ErrChunkNotFound) {
+			t.Errorf("%s: read with %v", c.name, err)
+		}
+		r.Close()
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	for _, c := range []struct {
+		used   string // x for sectors in use
+		count  int
+		offset int
+		length int
+	}{
+		{"xx", 1, 2, 3},
+		{"xx", 3, 2, 5},
+		{"xx.x", 1, 2, 4},
+		{"xx.x..x", 2, 4, 7},
+		{"xx.x.", 2, 4, 6},
+		{"xx...", 3, 2, 5},
+		{"xx.x.x", 1, 2, 6},
+	} {
+		r := &Region{used: make([]bool, len(c.used))}
+		for i := range c.used {
+			r.used[i] = c.used[i] == 'x'
+		}
+		offset := r.allocate(c.count)
+		if offset != c.offset || len(r.used) != c.length {
+			t.Errorf("%d in %q: at %d of %d sectors, want %d of %d", c.count, c.used, offset, len(r.used), c.offset, c.length)
+		}
+	}
+}
+
+// sectorsOf return offset and count of sectors of chunk x, z.
+func sectorsOf(r *Region, chunkX, chunkZ int32) (int, int) {
+	location := r.locations[chunkIndex(chunkX, chunkZ)]
+	return int(location >> 8), int(location & 0xFF)
+}
+
+func TestWriteChunkReuseSectors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "r.0.0.mca")
+	r, err := OpenRegion(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// random bytes can't be compressed, they take about as many sectors as their size
+	rnd := rand.New(rand.NewSource(1))
+	big := func(sectors int) nbt.Compound {
+		arr := make(nbt.ByteArray, sectors*sectorSize-sectorSize/2)
+		for i := range arr {
+			arr[i] = int8(rnd.Intn(256))
+		}
+		return nbt.Compound{"Data": arr}
+	}
+	small := nbt.Compound{"Data": nbt.Int(1)}
+
+	steps := []struct {
+		x, z   int32
+		root   nbt.Compound
+		offset int
+		count  int
+	}{
+		{0, 0, small, 2, 1},
+		{1, 0, small, 3, 1},
+		// chunk grown is moved to the end, its old sector is free
+		{0, 0, big(2), 4, 2},
+		{2, 0, small, 2, 1},
+		// old sectors are freed after the chunk is written, so it is appended
+		{0, 0, small, 6, 1},
+		{3, 0, small, 4, 1},
+	}
+	for i, step := range steps {
+		if err := r.WriteChunk(step.x, step.z, step.root); err != nil {
+			t.Fatal(err)
+		}
+		if offset, count := sectorsOf(r, step.x, step.z); offset != step.offset || count != step.count {
+			t.Errorf("step %d: chunk (%d, %d) in sectors %d+%d, want %d+%d", i, step.x, step.z, offset, count, step.offset, step.count)
+		}
+	}
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// header and sectors in use are the same after reopened
+	r, err = OpenRegion(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	for i := int32(0); i < 4; i++ {
+		root, err := r.ReadChunk(i, 0)
+		if err != nil {
+			t.Fatalf("chunk (%d, 0): %v", i, err)
+		}
+		if root["Data"] != nbt.Int(1) {
+			t.Errorf("chunk (%d, 0) is %v", i, root)
+		}
+	}
+	used := make([]byte, len(r.used))
+	for i, u := range r.used {
+		used[i] = '.'
+		if u {
+			used[i] = 'x'
+		}
+	}
+	// sector 5 was held by chunk (0, 0) before it shrunk
+	if want := "xxxxx.x"; string(used) != want {
+		t.Errorf("sectors used %q, want %q", used, want)
+	}
+}
@@ -0,0 +1,132 @@
+package anvil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/laushunyu/real/world"
+)
+
+// Storage loads and saves chunks in region files of <dir>/region and level in <dir>/level.dat,
+// regions of nether and end are in <dir>/DIM-1/region and <dir>/DIM1/region.
+type Storage struct {
+	dir       string
+	regionDir string
+
+	mu      sync.Mutex
+	regions map[string]*Region
+}
+
+var _ world.Storage = (*Storage)(nil)
+
+// NewStorage return storage of world saved in dir.
+func NewStorage(dir string) *Storage {
+	return NewDimensionStorage(dir, world.DimensionOverworld)
+}
+
+// NewDimensionStorage return storage of dimension of world saved in dir.
+func NewDimensionStorage(dir string, dimension int32) *Storage {
+	regionDir := filepath.Join(dir, "region")
+	if dimension != world.DimensionOverworld {
+		regionDir = filepath.Join(dir, fmt.Sprintf("DIM%d", dimension), "region")
+	}
+	return &Storage{
+		dir:       dir,
+		regionDir: regionDir,
+		regions:   make(map[string]*Region),
+	}
+}
+
+// region return opened region which chunk x, z belongs to,
+// nil if region file not exists and create is false.
+func (s *Storage) region(x, z int32, create bool) (*Region, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := RegionFileName(x, z)
+	if r, ok := s.regions[name]; ok {
+		return r, nil
+	}
+	dir := s.regionDir
+	if create {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+	}
+	r, err := OpenRegion(filepath.Join(dir, name), create)
+	if err != nil {
+		if !create && errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	s.regions[name] = r
+	return r, nil
+}
+
+func (s *Storage) LoadChunk(x, z int32) (*world.Chunk, error) {
+	r, err := s.region(x, z, false)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	root, err := r.ReadChunk(x, z)
+	if err != nil {
+		if errors.Is(err, ErrChunkNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return DecodeChunk(root)
+}
+
+func (s *Storage) SaveChunk(c *world.Chunk) error {
+	r, err := s.region(c.X, c.Z, true)
+	if err != nil {
+		return err
+	}
+	return r.WriteChunk(c.X, c.Z, EncodeChunk(c))
+}
+
+// LoadLevel read level.dat, level.dat_old is used if level.dat is broken.
+func (s *Storage) LoadLevel() (*world.Level, error) {
+	path := filepath.Join(s.dir, "level.dat")
+	l, err := ReadLevel(path)
+	if err == nil {
+		return l, nil
+	}
+	if old, oldErr := ReadLevel(path + "_old"); oldErr == nil {
+		return old, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return nil, err
+}
+
+func (s *Storage) SaveLevel(l *world.Level) error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	return WriteLevel(filepath.Join(s.dir, "level.dat"), l)
+}
+
+// Close sync and close all opened region files.
+func (s *Storage) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var firstErr error
+	for name, r := range s.regions {
+		if err := r.Sync(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if err := r.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(s.regions, name)
+	}
+	return firstErr
+}
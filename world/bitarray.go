@@ -0,0 +1,48 @@
+package world
+
+// BitArray packs fixed-size values into longs,
+// a value may span two longs as the protocol of 1.12 expects.
+type BitArray struct {
+	bits uint8
+	mask uint64
+	data []uint64
+}
+
+// NewBitArray return array of length values with bits per value.
+func NewBitArray(bits uint8, length int) *BitArray {
+	return &BitArray{
+		bits: bits,
+		mask: 1<<bits - 1,
+		data: make([]uint64, (length*int(bits)+63)/64),
+	}
+}
+
+func (a *BitArray) Bits() uint8 {
+	return a.bits
+}
+
+// Longs return the underlying longs.
+func (a *BitArray) Longs() []uint64 {
+	return a.data
+}
+
+func (a *BitArray) Get(i int) uint64 {
+	bit := i * int(a.bits)
+	start, offset := bit/64, uint(bit%64)
+	v := a.data[start] >> offset
+	if offset+uint(a.bits) > 64 {
+		v |= a.data[start+1] << (64 - offset)
+	}
+	return v & a.mask
+}
+
+func (a *BitArray) Set(i int, v uint64) {
+	v &= a.mask
+	bit := i * int(a.bits)
+	start, offset := bit/64, uint(bit%64)
+	a.data[start] = a.data[start]&^(a.mask<<offset) | v<<offset
+	if offset+uint(a.bits) > 64 {
+		end := 64 - offset
+		a.data[start+1] = a.data[start+1]&^(a.mask>>end) | v>>end
+	}
+}
@@ -0,0 +1,53 @@
+package world
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBitArray(t *testing.T) {
+	for _, bits := range []uint8{1, 4, 5, 8, 13, 14, 31} {
+		a := NewBitArray(bits, sectionVolume)
+		if want := (sectionVolume*int(bits) + 63) / 64; len(a.Longs()) != want {
+			t.Errorf("%d bits: %d longs, want %d", bits, len(a.Longs()), want)
+		}
+
+		r := rand.New(rand.NewSource(int64(bits)))
+		values := make([]uint64, sectionVolume)
+		for i := range values {
+			values[i] = r.Uint64() & (1<<bits - 1)
+			a.Set(i, values[i])
+		}
+		// setting a value doesn't touch the ones next to it
+		for i := range values {
+			if v := a.Get(i); v != values[i] {
+				t.Fatalf("%d bits: value %d is %d, want %d", bits, i, v, values[i])
+			}
+		}
+
+		// values too large are cut to bits
+		a.Set(1, 1<<bits|1)
+		if v := a.Get(1); v != 1 {
+			t.Errorf("%d bits: value set with overflow is %d", bits, v)
+		}
+		if a.Get(0) != values[0] || a.Get(2) != values[2] {
+			t.Errorf("%d bits: overflow changed values next to it", bits)
+		}
+	}
+}
+
+func TestBitArraySpanLongs(t *testing.T) {
+	// with 5 bits, value 12 is in bits 60..64, the last 4 bits of long 0 and the first of long 1
+	a := NewBitArray(5, 64)
+	a.Set(12, 0x1F)
+	if longs := a.Longs(); longs[0] != 0xF<<60 || longs[1] != 1 {
+		t.Errorf("longs are %#x %#x", longs[0], longs[1])
+	}
+	if v := a.Get(12); v != 0x1F {
+		t.Errorf("value spanning longs is %#x", v)
+	}
+	a.Set(12, 0)
+	if longs := a.Longs(); longs[0] != 0 || longs[1] != 0 {
+		t.Errorf("longs are %#x %#x after cleared", longs[0], longs[1])
+	}
+}
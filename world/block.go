@@ -0,0 +1,191 @@
+package world
+
+//go:generate go run gen_blocks.go
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BlockState is the global palette id of 1.12, id<<4 | meta.
+type BlockState uint16
+
+func NewBlockState(id uint16, meta uint8) BlockState {
+	return BlockState(id<<4 | uint16(meta&0xF))
+}
+
+func (s BlockState) ID() uint16 {
+	return uint16(s) >> 4
+}
+
+func (s BlockState) Meta() uint8 {
+	return uint8(s & 0xF)
+}
+
+// Block return block of state, nil if id is unknown.
+func (s BlockState) Block() *Block {
+	return BlockByID(s.ID())
+}
+
+// Properties return properties of state, sorted by name.
+func (s BlockState) Properties() []Property {
+	b := s.Block()
+	if b == nil {
+		return nil
+	}
+	if v := b.variant(s.Meta()); v != nil {
+		return v.Properties
+	}
+	return nil
+}
+
+// Valid report whether state is a known block and meta.
+func (s BlockState) Valid() bool {
+	b := s.Block()
+	return b != nil && b.variant(s.Meta()) != nil
+}
+
+// String return state like minecraft:stone[variant=granite].
+func (s BlockState) String() string {
+	b := s.Block()
+	if b == nil || b.variant(s.Meta()) == nil {
+		return fmt.Sprintf("BlockState(%d:%d)", s.ID(), s.Meta())
+	}
+	props := s.Properties()
+	if len(props) == 0 {
+		return b.Name
+	}
+	kv := make([]string, len(props))
+	for i, p := range props {
+		kv[i] = p.Name + "=" + p.Value
+	}
+	return b.Name + "[" + strings.Join(kv, ",") + "]"
+}
+
+// Air is the block state of empty block.
+const Air BlockState = 0
+
+type Property struct {
+	Name, Value string
+}
+
+// BlockVariant is a valid meta of block and its properties.
+type BlockVariant struct {
+	Meta       uint8
+	Properties []Property
+}
+
+type Block struct {
+	ID       uint16
+	Name     string
+	Hardness float32 // -1 means unbreakable
+	Light    uint8   // light emission level
+	Opacity  uint8   // how much light is reduced going through it, 255 for opaque
+	Solid    bool    // full cube that entities can stand on
+	Variants []BlockVariant
+}
+
+func (b *Block) variant(meta uint8) *BlockVariant {
+	for i := range b.Variants {
+		if b.Variants[i].Meta == meta {
+			return &b.Variants[i]
+		}
+	}
+	return nil
+}
+
+// DefaultState return the first variant of block.
+func (b *Block) DefaultState() BlockState {
+	return NewBlockState(b.ID, b.Variants[0].Meta)
+}
+
+// State return the first variant which has all properties in props.
+func (b *Block) State(props map[string]string) (BlockState, bool) {
+	for _, v := range b.Variants {
+		matched := 0
+		for _, p := range v.Properties {
+			if value, ok := props[p.Name]; ok {
+				if value != p.Value {
+					break
+				}
+				matched++
+			}
+		}
+		if matched == len(props) {
+			return NewBlockState(b.ID, v.Meta), true
+		}
+	}
+	return Air, false
+}
+
+// Unbreakable report whether block can not be broken in survival.
+func (b *Block) Unbreakable() bool {
+	return b.Hardness < 0
+}
+
+var (
+	blockByID   [256]*Block
+	blockByName = make(map[string]*Block)
+)
+
+func init() {
+	for i := range blocks {
+		b := &blocks[i]
+		blockByID[b.ID] = b
+		blockByName[b.Name] = b
+	}
+}
+
+// BlockByID return block of id, nil if not found.
+func BlockByID(id uint16) *Block {
+	if int(id) >= len(blockByID) {
+		return nil
+	}
+	return blockByID[id]
+}
+
+// BlockByName return block of name, the minecraft: prefix can be omitted.
+func BlockByName(name string) *Block {
+	if !strings.Contains(name, ":") {
+		name = "minecraft:" + name
+	}
+	return blockByName[name]
+}
+
+// ParseBlockState parse state like minecraft:stone[variant=granite],
+// properties not given will match the first variant.
+func ParseBlockState(str string) (BlockState, error) {
+	name, props := str, map[string]string{}
+	if i := strings.IndexByte(str, '['); i >= 0 {
+		if !strings.HasSuffix(str, "]") {
+			return Air, fmt.Errorf("invalid block state %q", str)
+		}
+		name = str[:i]
+		for _, kv := range strings.Split(str[i+1:len(str)-1], ",") {
+			k, v, ok := strings.Cut(kv, "=")
+			if !ok {
+				return Air, fmt.Errorf("invalid property %q of block state %q", kv, str)
+			}
+			props[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+
+	b := BlockByName(name)
+	if b == nil {
+		return Air, fmt.Errorf("unknown block %q", name)
+	}
+	state, ok := b.State(props)
+	if !ok {
+		return Air, fmt.Errorf("no variant of %s matches %q", b.Name, str)
+	}
+	return state, nil
+}
+
+// MustParseBlockState is like ParseBlockState but panics if str is invalid.
+func MustParseBlockState(str string) BlockState {
+	state, err := ParseBlockState(str)
+	if err != nil {
+		panic(err)
+	}
+	return state
+}
@@ -0,0 +1,2447 @@
+// Code generated by "go run gen_blocks.go"; DO NOT EDIT.
+
+package world
+
+// ids of blocks
+const (
+	BlockAir                        uint16 = 0
+	BlockStone                      uint16 = 1
+	BlockGrass                      uint16 = 2
+	BlockDirt                       uint16 = 3
+	BlockCobblestone                uint16 = 4
+	BlockPlanks                     uint16 = 5
+	BlockSapling                    uint16 = 6
+	BlockBedrock                    uint16 = 7
+	BlockFlowingWater               uint16 = 8
+	BlockWater                      uint16 = 9
+	BlockFlowingLava                uint16 = 10
+	BlockLava                       uint16 = 11
+	BlockSand                       uint16 = 12
+	BlockGravel                     uint16 = 13
+	BlockGoldOre                    uint16 = 14
+	BlockIronOre                    uint16 = 15
+	BlockCoalOre                    uint16 = 16
+	BlockLog                        uint16 = 17
+	BlockLeaves                     uint16 = 18
+	BlockSponge                     uint16 = 19
+	BlockGlass                      uint16 = 20
+	BlockLapisOre                   uint16 = 21
+	BlockLapisBlock                 uint16 = 22
+	BlockDispenser                  uint16 = 23
+	BlockSandstone                  uint16 = 24
+	BlockNoteblock                  uint16 = 25
+	BlockBed                        uint16 = 26
+	BlockGoldenRail                 uint16 = 27
+	BlockDetectorRail               uint16 = 28
+	BlockStickyPiston               uint16 = 29
+	BlockWeb                        uint16 = 30
+	BlockTallgrass                  uint16 = 31
+	BlockDeadbush                   uint16 = 32
+	BlockPiston                     uint16 = 33
+	BlockPistonHead                 uint16 = 34
+	BlockWool                       uint16 = 35
+	BlockPistonExtension            uint16 = 36
+	BlockYellowFlower               uint16 = 37
+	BlockRedFlower                  uint16 = 38
+	BlockBrownMushroom              uint16 = 39
+	BlockRedMushroom                uint16 = 40
+	BlockGoldBlock                  uint16 = 41
+	BlockIronBlock                  uint16 = 42
+	BlockDoubleStoneSlab            uint16 = 43
+	BlockStoneSlab                  uint16 = 44
+	BlockBrickBlock                 uint16 = 45
+	BlockTnt                        uint16 = 46
+	BlockBookshelf                  uint16 = 47
+	BlockMossyCobblestone           uint16 = 48
+	BlockObsidian                   uint16 = 49
+	BlockTorch                      uint16 = 50
+	BlockFire                       uint16 = 51
+	BlockMobSpawner                 uint16 = 52
+	BlockOakStairs                  uint16 = 53
+	BlockChest                      uint16 = 54
+	BlockRedstoneWire               uint16 = 55
+	BlockDiamondOre                 uint16 = 56
+	BlockDiamondBlock               uint16 = 57
+	BlockCraftingTable              uint16 = 58
+	BlockWheat                      uint16 = 59
+	BlockFarmland                   uint16 = 60
+	BlockFurnace                    uint16 = 61
+	BlockLitFurnace                 uint16 = 62
+	BlockStandingSign               uint16 = 63
+	BlockWoodenDoor                 uint16 = 64
+	BlockLadder                     uint16 = 65
+	BlockRail                       uint16 = 66
+	BlockStoneStairs                uint16 = 67
+	BlockWallSign                   uint16 = 68
+	BlockLever                      uint16 = 69
+	BlockStonePressurePlate         uint16 = 70
+	BlockIronDoor                   uint16 = 71
+	BlockWoodenPressurePlate        uint16 = 72
+	BlockRedstoneOre                uint16 = 73
+	BlockLitRedstoneOre             uint16 = 74
+	BlockUnlitRedstoneTorch         uint16 = 75
+	BlockRedstoneTorch              uint16 = 76
+	BlockStoneButton                uint16 = 77
+	BlockSnowLayer                  uint16 = 78
+	BlockIce                        uint16 = 79
+	BlockSnow                       uint16 = 80
+	BlockCactus                     uint16 = 81
+	BlockClay                       uint16 = 82
+	BlockReeds                      uint16 = 83
+	BlockJukebox                    uint16 = 84
+	BlockFence                      uint16 = 85
+	BlockPumpkin                    uint16 = 86
+	BlockNetherrack                 uint16 = 87
+	BlockSoulSand                   uint16 = 88
+	BlockGlowstone                  uint16 = 89
+	BlockPortal                     uint16 = 90
+	BlockLitPumpkin                 uint16 = 91
+	BlockCake                       uint16 = 92
+	BlockUnpoweredRepeater          uint16 = 93
+	BlockPoweredRepeater            uint16 = 94
+	BlockStainedGlass               uint16 = 95
+	BlockTrapdoor                   uint16 = 96
+	BlockMonsterEgg                 uint16 = 97
+	BlockStonebrick                 uint16 = 98
+	BlockBrownMushroomBlock         uint16 = 99
+	BlockRedMushroomBlock           uint16 = 100
+	BlockIronBars                   uint16 = 101
+	BlockGlassPane                  uint16 = 102
+	BlockMelonBlock                 uint16 = 103
+	BlockPumpkinStem                uint16 = 104
+	BlockMelonStem                  uint16 = 105
+	BlockVine                       uint16 = 106
+	BlockFenceGate                  uint16 = 107
+	BlockBrickStairs                uint16 = 108
+	BlockStoneBrickStairs           uint16 = 109
+	BlockMycelium                   uint16 = 110
+	BlockWaterlily                  uint16 = 111
+	BlockNetherBrick                uint16 = 112
+	BlockNetherBrickFence           uint16 = 113
+	BlockNetherBrickStairs          uint16 = 114
+	BlockNetherWart                 uint16 = 115
+	BlockEnchantingTable            uint16 = 116
+	BlockBrewingStand               uint16 = 117
+	BlockCauldron                   uint16 = 118
+	BlockEndPortal                  uint16 = 119
+	BlockEndPortalFrame             uint16 = 120
+	BlockEndStone                   uint16 = 121
+	BlockDragonEgg                  uint16 = 122
+	BlockRedstoneLamp               uint16 = 123
+	BlockLitRedstoneLamp            uint16 = 124
+	BlockDoubleWoodenSlab           uint16 = 125
+	BlockWoodenSlab                 uint16 = 126
+	BlockCocoa                      uint16 = 127
+	BlockSandstoneStairs            uint16 = 128
+	BlockEmeraldOre                 uint16 = 129
+	BlockEnderChest                 uint16 = 130
+	BlockTripwireHook               uint16 = 131
+	BlockTripwire                   uint16 = 132
+	BlockEmeraldBlock               uint16 = 133
+	BlockSpruceStairs               uint16 = 134
+	BlockBirchStairs                uint16 = 135
+	BlockJungleStairs               uint16 = 136
+	BlockCommandBlock               uint16 = 137
+	BlockBeacon                     uint16 = 138
+	BlockCobblestoneWall            uint16 = 139
+	BlockFlowerPot                  uint16 = 140
+	BlockCarrots                    uint16 = 141
+	BlockPotatoes                   uint16 = 142
+	BlockWoodenButton               uint16 = 143
+	BlockSkull                      uint16 = 144
+	BlockAnvil                      uint16 = 145
+	BlockTrappedChest               uint16 = 146
+	BlockLightWeightedPressurePlate uint16 = 147
+	BlockHeavyWeightedPressurePlate uint16 = 148
+	BlockUnpoweredComparator        uint16 = 149
+	BlockPoweredComparator          uint16 = 150
+	BlockDaylightDetector           uint16 = 151
+	BlockRedstoneBlock              uint16 = 152
+	BlockQuartzOre                  uint16 = 153
+	BlockHopper                     uint16 = 154
+	BlockQuartzBlock                uint16 = 155
+	BlockQuartzStairs               uint16 = 156
+	BlockActivatorRail              uint16 = 157
+	BlockDropper                    uint16 = 158
+	BlockStainedHardenedClay        uint16 = 159
+	BlockStainedGlassPane           uint16 = 160
+	BlockLeaves2                    uint16 = 161
+	BlockLog2                       uint16 = 162
+	BlockAcaciaStairs               uint16 = 163
+	BlockDarkOakStairs              uint16 = 164
+	BlockSlime                      uint16 = 165
+	BlockBarrier                    uint16 = 166
+	BlockIronTrapdoor               uint16 = 167
+	BlockPrismarine                 uint16 = 168
+	BlockSeaLantern                 uint16 = 169
+	BlockHayBlock                   uint16 = 170
+	BlockCarpet                     uint16 = 171
+	BlockHardenedClay               uint16 = 172
+	BlockCoalBlock                  uint16 = 173
+	BlockPackedIce                  uint16 = 174
+	BlockDoublePlant                uint16 = 175
+	BlockStandingBanner             uint16 = 176
+	BlockWallBanner                 uint16 = 177
+	BlockDaylightDetectorInverted   uint16 = 178
+	BlockRedSandstone               uint16 = 179
+	BlockRedSandstoneStairs         uint16 = 180
+	BlockDoubleStoneSlab2           uint16 = 181
+	BlockStoneSlab2                 uint16 = 182
+	BlockSpruceFenceGate            uint16 = 183
+	BlockBirchFenceGate             uint16 = 184
+	BlockJungleFenceGate            uint16 = 185
+	BlockDarkOakFenceGate           uint16 = 186
+	BlockAcaciaFenceGate            uint16 = 187
+	BlockSpruceFence                uint16 = 188
+	BlockBirchFence                 uint16 = 189
+	BlockJungleFence                uint16 = 190
+	BlockDarkOakFence               uint16 = 191
+	BlockAcaciaFence                uint16 = 192
+	BlockSpruceDoor                 uint16 = 193
+	BlockBirchDoor                  uint16 = 194
+	BlockJungleDoor                 uint16 = 195
+	BlockAcaciaDoor                 uint16 = 196
+	BlockDarkOakDoor                uint16 = 197
+	BlockEndRod                     uint16 = 198
+	BlockChorusPlant                uint16 = 199
+	BlockChorusFlower               uint16 = 200
+	BlockPurpurBlock                uint16 = 201
+	BlockPurpurPillar               uint16 = 202
+	BlockPurpurStairs               uint16 = 203
+	BlockPurpurDoubleSlab           uint16 = 204
+	BlockPurpurSlab                 uint16 = 205
+	BlockEndBricks                  uint16 = 206
+	BlockBeetroots                  uint16 = 207
+	BlockGrassPath                  uint16 = 208
+	BlockEndGateway                 uint16 = 209
+	BlockRepeatingCommandBlock      uint16 = 210
+	BlockChainCommandBlock          uint16 = 211
+	BlockFrostedIce                 uint16 = 212
+	BlockMagma                      uint16 = 213
+	BlockNetherWartBlock            uint16 = 214
+	BlockRedNetherBrick             uint16 = 215
+	BlockBoneBlock                  uint16 = 216
+	BlockStructureVoid              uint16 = 217
+	BlockObserver                   uint16 = 218
+	BlockWhiteShulkerBox            uint16 = 219
+	BlockOrangeShulkerBox           uint16 = 220
+	BlockMagentaShulkerBox          uint16 = 221
+	BlockLightBlueShulkerBox        uint16 = 222
+	BlockYellowShulkerBox           uint16 = 223
+	BlockLimeShulkerBox             uint16 = 224
+	BlockPinkShulkerBox             uint16 = 225
+	BlockGrayShulkerBox             uint16 = 226
+	BlockSilverShulkerBox           uint16 = 227
+	BlockCyanShulkerBox             uint16 = 228
+	BlockPurpleShulkerBox           uint16 = 229
+	BlockBlueShulkerBox             uint16 = 230
+	BlockBrownShulkerBox            uint16 = 231
+	BlockGreenShulkerBox            uint16 = 232
+	BlockRedShulkerBox              uint16 = 233
+	BlockBlackShulkerBox            uint16 = 234
+	BlockWhiteGlazedTerracotta      uint16 = 235
+	BlockOrangeGlazedTerracotta     uint16 = 236
+	BlockMagentaGlazedTerracotta    uint16 = 237
+	BlockLightBlueGlazedTerracotta  uint16 = 238
+	BlockYellowGlazedTerracotta     uint16 = 239
+	BlockLimeGlazedTerracotta       uint16 = 240
+	BlockPinkGlazedTerracotta       uint16 = 241
+	BlockGrayGlazedTerracotta       uint16 = 242
+	BlockSilverGlazedTerracotta     uint16 = 243
+	BlockCyanGlazedTerracotta       uint16 = 244
+	BlockPurpleGlazedTerracotta     uint16 = 245
+	BlockBlueGlazedTerracotta       uint16 = 246
+	BlockBrownGlazedTerracotta      uint16 = 247
+	BlockGreenGlazedTerracotta      uint16 = 248
+	BlockRedGlazedTerracotta        uint16 = 249
+	BlockBlackGlazedTerracotta      uint16 = 250
+	BlockConcrete                   uint16 = 251
+	BlockConcretePowder             uint16 = 252
+	BlockStructureBlock             uint16 = 255
+)
+
+var blocks = []Block{
+	{ID: 0, Name: "minecraft:air", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 1, Name: "minecraft:stone", Hardness: 1.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "stone"}}},
+		{Meta: 1, Properties: []Property{{"variant", "granite"}}},
+		{Meta: 2, Properties: []Property{{"variant", "smooth_granite"}}},
+		{Meta: 3, Properties: []Property{{"variant", "diorite"}}},
+		{Meta: 4, Properties: []Property{{"variant", "smooth_diorite"}}},
+		{Meta: 5, Properties: []Property{{"variant", "andesite"}}},
+		{Meta: 6, Properties: []Property{{"variant", "smooth_andesite"}}},
+	}},
+	{ID: 2, Name: "minecraft:grass", Hardness: 0.6, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"snowy", "false"}}},
+	}},
+	{ID: 3, Name: "minecraft:dirt", Hardness: 0.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"snowy", "false"}, {"variant", "dirt"}}},
+		{Meta: 1, Properties: []Property{{"snowy", "false"}, {"variant", "coarse_dirt"}}},
+		{Meta: 2, Properties: []Property{{"snowy", "false"}, {"variant", "podzol"}}},
+	}},
+	{ID: 4, Name: "minecraft:cobblestone", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 5, Name: "minecraft:planks", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "oak"}}},
+		{Meta: 1, Properties: []Property{{"variant", "spruce"}}},
+		{Meta: 2, Properties: []Property{{"variant", "birch"}}},
+		{Meta: 3, Properties: []Property{{"variant", "jungle"}}},
+		{Meta: 4, Properties: []Property{{"variant", "acacia"}}},
+		{Meta: 5, Properties: []Property{{"variant", "dark_oak"}}},
+	}},
+	{ID: 6, Name: "minecraft:sapling", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"stage", "0"}, {"type", "oak"}}},
+		{Meta: 1, Properties: []Property{{"stage", "0"}, {"type", "spruce"}}},
+		{Meta: 2, Properties: []Property{{"stage", "0"}, {"type", "birch"}}},
+		{Meta: 3, Properties: []Property{{"stage", "0"}, {"type", "jungle"}}},
+		{Meta: 4, Properties: []Property{{"stage", "0"}, {"type", "acacia"}}},
+		{Meta: 5, Properties: []Property{{"stage", "0"}, {"type", "dark_oak"}}},
+		{Meta: 8, Properties: []Property{{"stage", "1"}, {"type", "oak"}}},
+		{Meta: 9, Properties: []Property{{"stage", "1"}, {"type", "spruce"}}},
+		{Meta: 10, Properties: []Property{{"stage", "1"}, {"type", "birch"}}},
+		{Meta: 11, Properties: []Property{{"stage", "1"}, {"type", "jungle"}}},
+		{Meta: 12, Properties: []Property{{"stage", "1"}, {"type", "acacia"}}},
+		{Meta: 13, Properties: []Property{{"stage", "1"}, {"type", "dark_oak"}}},
+	}},
+	{ID: 7, Name: "minecraft:bedrock", Hardness: -1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 8, Name: "minecraft:flowing_water", Hardness: 100, Light: 0, Opacity: 3, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"level", "0"}}},
+		{Meta: 1, Properties: []Property{{"level", "1"}}},
+		{Meta: 2, Properties: []Property{{"level", "2"}}},
+		{Meta: 3, Properties: []Property{{"level", "3"}}},
+		{Meta: 4, Properties: []Property{{"level", "4"}}},
+		{Meta: 5, Properties: []Property{{"level", "5"}}},
+		{Meta: 6, Properties: []Property{{"level", "6"}}},
+		{Meta: 7, Properties: []Property{{"level", "7"}}},
+		{Meta: 8, Properties: []Property{{"level", "8"}}},
+		{Meta: 9, Properties: []Property{{"level", "9"}}},
+		{Meta: 10, Properties: []Property{{"level", "10"}}},
+		{Meta: 11, Properties: []Property{{"level", "11"}}},
+		{Meta: 12, Properties: []Property{{"level", "12"}}},
+		{Meta: 13, Properties: []Property{{"level", "13"}}},
+		{Meta: 14, Properties: []Property{{"level", "14"}}},
+		{Meta: 15, Properties: []Property{{"level", "15"}}},
+	}},
+	{ID: 9, Name: "minecraft:water", Hardness: 100, Light: 0, Opacity: 3, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"level", "0"}}},
+		{Meta: 1, Properties: []Property{{"level", "1"}}},
+		{Meta: 2, Properties: []Property{{"level", "2"}}},
+		{Meta: 3, Properties: []Property{{"level", "3"}}},
+		{Meta: 4, Properties: []Property{{"level", "4"}}},
+		{Meta: 5, Properties: []Property{{"level", "5"}}},
+		{Meta: 6, Properties: []Property{{"level", "6"}}},
+		{Meta: 7, Properties: []Property{{"level", "7"}}},
+		{Meta: 8, Properties: []Property{{"level", "8"}}},
+		{Meta: 9, Properties: []Property{{"level", "9"}}},
+		{Meta: 10, Properties: []Property{{"level", "10"}}},
+		{Meta: 11, Properties: []Property{{"level", "11"}}},
+		{Meta: 12, Properties: []Property{{"level", "12"}}},
+		{Meta: 13, Properties: []Property{{"level", "13"}}},
+		{Meta: 14, Properties: []Property{{"level", "14"}}},
+		{Meta: 15, Properties: []Property{{"level", "15"}}},
+	}},
+	{ID: 10, Name: "minecraft:flowing_lava", Hardness: 100, Light: 15, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"level", "0"}}},
+		{Meta: 1, Properties: []Property{{"level", "1"}}},
+		{Meta: 2, Properties: []Property{{"level", "2"}}},
+		{Meta: 3, Properties: []Property{{"level", "3"}}},
+		{Meta: 4, Properties: []Property{{"level", "4"}}},
+		{Meta: 5, Properties: []Property{{"level", "5"}}},
+		{Meta: 6, Properties: []Property{{"level", "6"}}},
+		{Meta: 7, Properties: []Property{{"level", "7"}}},
+		{Meta: 8, Properties: []Property{{"level", "8"}}},
+		{Meta: 9, Properties: []Property{{"level", "9"}}},
+		{Meta: 10, Properties: []Property{{"level", "10"}}},
+		{Meta: 11, Properties: []Property{{"level", "11"}}},
+		{Meta: 12, Properties: []Property{{"level", "12"}}},
+		{Meta: 13, Properties: []Property{{"level", "13"}}},
+		{Meta: 14, Properties: []Property{{"level", "14"}}},
+		{Meta: 15, Properties: []Property{{"level", "15"}}},
+	}},
+	{ID: 11, Name: "minecraft:lava", Hardness: 100, Light: 15, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"level", "0"}}},
+		{Meta: 1, Properties: []Property{{"level", "1"}}},
+		{Meta: 2, Properties: []Property{{"level", "2"}}},
+		{Meta: 3, Properties: []Property{{"level", "3"}}},
+		{Meta: 4, Properties: []Property{{"level", "4"}}},
+		{Meta: 5, Properties: []Property{{"level", "5"}}},
+		{Meta: 6, Properties: []Property{{"level", "6"}}},
+		{Meta: 7, Properties: []Property{{"level", "7"}}},
+		{Meta: 8, Properties: []Property{{"level", "8"}}},
+		{Meta: 9, Properties: []Property{{"level", "9"}}},
+		{Meta: 10, Properties: []Property{{"level", "10"}}},
+		{Meta: 11, Properties: []Property{{"level", "11"}}},
+		{Meta: 12, Properties: []Property{{"level", "12"}}},
+		{Meta: 13, Properties: []Property{{"level", "13"}}},
+		{Meta: 14, Properties: []Property{{"level", "14"}}},
+		{Meta: 15, Properties: []Property{{"level", "15"}}},
+	}},
+	{ID: 12, Name: "minecraft:sand", Hardness: 0.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "sand"}}},
+		{Meta: 1, Properties: []Property{{"variant", "red_sand"}}},
+	}},
+	{ID: 13, Name: "minecraft:gravel", Hardness: 0.6, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 14, Name: "minecraft:gold_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 15, Name: "minecraft:iron_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 16, Name: "minecraft:coal_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 17, Name: "minecraft:log", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"axis", "y"}, {"variant", "oak"}}},
+		{Meta: 1, Properties: []Property{{"axis", "y"}, {"variant", "spruce"}}},
+		{Meta: 2, Properties: []Property{{"axis", "y"}, {"variant", "birch"}}},
+		{Meta: 3, Properties: []Property{{"axis", "y"}, {"variant", "jungle"}}},
+		{Meta: 4, Properties: []Property{{"axis", "x"}, {"variant", "oak"}}},
+		{Meta: 5, Properties: []Property{{"axis", "x"}, {"variant", "spruce"}}},
+		{Meta: 6, Properties: []Property{{"axis", "x"}, {"variant", "birch"}}},
+		{Meta: 7, Properties: []Property{{"axis", "x"}, {"variant", "jungle"}}},
+		{Meta: 8, Properties: []Property{{"axis", "z"}, {"variant", "oak"}}},
+		{Meta: 9, Properties: []Property{{"axis", "z"}, {"variant", "spruce"}}},
+		{Meta: 10, Properties: []Property{{"axis", "z"}, {"variant", "birch"}}},
+		{Meta: 11, Properties: []Property{{"axis", "z"}, {"variant", "jungle"}}},
+		{Meta: 12, Properties: []Property{{"axis", "none"}, {"variant", "oak"}}},
+		{Meta: 13, Properties: []Property{{"axis", "none"}, {"variant", "spruce"}}},
+		{Meta: 14, Properties: []Property{{"axis", "none"}, {"variant", "birch"}}},
+		{Meta: 15, Properties: []Property{{"axis", "none"}, {"variant", "jungle"}}},
+	}},
+	{ID: 18, Name: "minecraft:leaves", Hardness: 0.2, Light: 0, Opacity: 1, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"check_decay", "false"}, {"decayable", "true"}, {"variant", "oak"}}},
+		{Meta: 1, Properties: []Property{{"check_decay", "false"}, {"decayable", "true"}, {"variant", "spruce"}}},
+		{Meta: 2, Properties: []Property{{"check_decay", "false"}, {"decayable", "true"}, {"variant", "birch"}}},
+		{Meta: 3, Properties: []Property{{"check_decay", "false"}, {"decayable", "true"}, {"variant", "jungle"}}},
+		{Meta: 4, Properties: []Property{{"check_decay", "false"}, {"decayable", "false"}, {"variant", "oak"}}},
+		{Meta: 5, Properties: []Property{{"check_decay", "false"}, {"decayable", "false"}, {"variant", "spruce"}}},
+		{Meta: 6, Properties: []Property{{"check_decay", "false"}, {"decayable", "false"}, {"variant", "birch"}}},
+		{Meta: 7, Properties: []Property{{"check_decay", "false"}, {"decayable", "false"}, {"variant", "jungle"}}},
+		{Meta: 8, Properties: []Property{{"check_decay", "true"}, {"decayable", "true"}, {"variant", "oak"}}},
+		{Meta: 9, Properties: []Property{{"check_decay", "true"}, {"decayable", "true"}, {"variant", "spruce"}}},
+		{Meta: 10, Properties: []Property{{"check_decay", "true"}, {"decayable", "true"}, {"variant", "birch"}}},
+		{Meta: 11, Properties: []Property{{"check_decay", "true"}, {"decayable", "true"}, {"variant", "jungle"}}},
+		{Meta: 12, Properties: []Property{{"check_decay", "true"}, {"decayable", "false"}, {"variant", "oak"}}},
+		{Meta: 13, Properties: []Property{{"check_decay", "true"}, {"decayable", "false"}, {"variant", "spruce"}}},
+		{Meta: 14, Properties: []Property{{"check_decay", "true"}, {"decayable", "false"}, {"variant", "birch"}}},
+		{Meta: 15, Properties: []Property{{"check_decay", "true"}, {"decayable", "false"}, {"variant", "jungle"}}},
+	}},
+	{ID: 19, Name: "minecraft:sponge", Hardness: 0.6, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"wet", "false"}}},
+		{Meta: 1, Properties: []Property{{"wet", "true"}}},
+	}},
+	{ID: 20, Name: "minecraft:glass", Hardness: 0.3, Light: 0, Opacity: 0, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 21, Name: "minecraft:lapis_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 22, Name: "minecraft:lapis_block", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 23, Name: "minecraft:dispenser", Hardness: 3.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"triggered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}, {"triggered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"triggered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"triggered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}, {"triggered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}, {"triggered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"triggered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "up"}, {"triggered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"triggered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"triggered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "west"}, {"triggered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "east"}, {"triggered", "true"}}},
+	}},
+	{ID: 24, Name: "minecraft:sandstone", Hardness: 0.8, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"type", "sandstone"}}},
+		{Meta: 1, Properties: []Property{{"type", "chiseled_sandstone"}}},
+		{Meta: 2, Properties: []Property{{"type", "smooth_sandstone"}}},
+	}},
+	{ID: 25, Name: "minecraft:noteblock", Hardness: 0.8, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 26, Name: "minecraft:bed", Hardness: 0.2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"occupied", "false"}, {"part", "foot"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"occupied", "false"}, {"part", "foot"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"occupied", "false"}, {"part", "foot"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"occupied", "false"}, {"part", "foot"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"occupied", "true"}, {"part", "foot"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"occupied", "true"}, {"part", "foot"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"occupied", "true"}, {"part", "foot"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"occupied", "true"}, {"part", "foot"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"occupied", "false"}, {"part", "head"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"occupied", "false"}, {"part", "head"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"occupied", "false"}, {"part", "head"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"occupied", "false"}, {"part", "head"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"occupied", "true"}, {"part", "head"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"occupied", "true"}, {"part", "head"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"occupied", "true"}, {"part", "head"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"occupied", "true"}, {"part", "head"}}},
+	}},
+	{ID: 27, Name: "minecraft:golden_rail", Hardness: 0.7, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"powered", "false"}, {"shape", "north_south"}}},
+		{Meta: 1, Properties: []Property{{"powered", "false"}, {"shape", "east_west"}}},
+		{Meta: 2, Properties: []Property{{"powered", "false"}, {"shape", "ascending_east"}}},
+		{Meta: 3, Properties: []Property{{"powered", "false"}, {"shape", "ascending_west"}}},
+		{Meta: 4, Properties: []Property{{"powered", "false"}, {"shape", "ascending_north"}}},
+		{Meta: 5, Properties: []Property{{"powered", "false"}, {"shape", "ascending_south"}}},
+		{Meta: 8, Properties: []Property{{"powered", "true"}, {"shape", "north_south"}}},
+		{Meta: 9, Properties: []Property{{"powered", "true"}, {"shape", "east_west"}}},
+		{Meta: 10, Properties: []Property{{"powered", "true"}, {"shape", "ascending_east"}}},
+		{Meta: 11, Properties: []Property{{"powered", "true"}, {"shape", "ascending_west"}}},
+		{Meta: 12, Properties: []Property{{"powered", "true"}, {"shape", "ascending_north"}}},
+		{Meta: 13, Properties: []Property{{"powered", "true"}, {"shape", "ascending_south"}}},
+	}},
+	{ID: 28, Name: "minecraft:detector_rail", Hardness: 0.7, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"powered", "false"}, {"shape", "north_south"}}},
+		{Meta: 1, Properties: []Property{{"powered", "false"}, {"shape", "east_west"}}},
+		{Meta: 2, Properties: []Property{{"powered", "false"}, {"shape", "ascending_east"}}},
+		{Meta: 3, Properties: []Property{{"powered", "false"}, {"shape", "ascending_west"}}},
+		{Meta: 4, Properties: []Property{{"powered", "false"}, {"shape", "ascending_north"}}},
+		{Meta: 5, Properties: []Property{{"powered", "false"}, {"shape", "ascending_south"}}},
+		{Meta: 8, Properties: []Property{{"powered", "true"}, {"shape", "north_south"}}},
+		{Meta: 9, Properties: []Property{{"powered", "true"}, {"shape", "east_west"}}},
+		{Meta: 10, Properties: []Property{{"powered", "true"}, {"shape", "ascending_east"}}},
+		{Meta: 11, Properties: []Property{{"powered", "true"}, {"shape", "ascending_west"}}},
+		{Meta: 12, Properties: []Property{{"powered", "true"}, {"shape", "ascending_north"}}},
+		{Meta: 13, Properties: []Property{{"powered", "true"}, {"shape", "ascending_south"}}},
+	}},
+	{ID: 29, Name: "minecraft:sticky_piston", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"extended", "false"}, {"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"extended", "false"}, {"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"extended", "false"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"extended", "false"}, {"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"extended", "false"}, {"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"extended", "false"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"extended", "true"}, {"facing", "down"}}},
+		{Meta: 9, Properties: []Property{{"extended", "true"}, {"facing", "up"}}},
+		{Meta: 10, Properties: []Property{{"extended", "true"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"extended", "true"}, {"facing", "south"}}},
+		{Meta: 12, Properties: []Property{{"extended", "true"}, {"facing", "west"}}},
+		{Meta: 13, Properties: []Property{{"extended", "true"}, {"facing", "east"}}},
+	}},
+	{ID: 30, Name: "minecraft:web", Hardness: 4, Light: 0, Opacity: 1, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 31, Name: "minecraft:tallgrass", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"type", "dead_bush"}}},
+		{Meta: 1, Properties: []Property{{"type", "tall_grass"}}},
+		{Meta: 2, Properties: []Property{{"type", "fern"}}},
+	}},
+	{ID: 32, Name: "minecraft:deadbush", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 33, Name: "minecraft:piston", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"extended", "false"}, {"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"extended", "false"}, {"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"extended", "false"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"extended", "false"}, {"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"extended", "false"}, {"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"extended", "false"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"extended", "true"}, {"facing", "down"}}},
+		{Meta: 9, Properties: []Property{{"extended", "true"}, {"facing", "up"}}},
+		{Meta: 10, Properties: []Property{{"extended", "true"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"extended", "true"}, {"facing", "south"}}},
+		{Meta: 12, Properties: []Property{{"extended", "true"}, {"facing", "west"}}},
+		{Meta: 13, Properties: []Property{{"extended", "true"}, {"facing", "east"}}},
+	}},
+	{ID: 34, Name: "minecraft:piston_head", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"short", "false"}, {"type", "normal"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}, {"short", "false"}, {"type", "normal"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"short", "false"}, {"type", "normal"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"short", "false"}, {"type", "normal"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}, {"short", "false"}, {"type", "normal"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}, {"short", "false"}, {"type", "normal"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"short", "false"}, {"type", "sticky"}}},
+		{Meta: 9, Properties: []Property{{"facing", "up"}, {"short", "false"}, {"type", "sticky"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"short", "false"}, {"type", "sticky"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"short", "false"}, {"type", "sticky"}}},
+		{Meta: 12, Properties: []Property{{"facing", "west"}, {"short", "false"}, {"type", "sticky"}}},
+		{Meta: 13, Properties: []Property{{"facing", "east"}, {"short", "false"}, {"type", "sticky"}}},
+	}},
+	{ID: 35, Name: "minecraft:wool", Hardness: 0.8, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"color", "white"}}},
+		{Meta: 1, Properties: []Property{{"color", "orange"}}},
+		{Meta: 2, Properties: []Property{{"color", "magenta"}}},
+		{Meta: 3, Properties: []Property{{"color", "light_blue"}}},
+		{Meta: 4, Properties: []Property{{"color", "yellow"}}},
+		{Meta: 5, Properties: []Property{{"color", "lime"}}},
+		{Meta: 6, Properties: []Property{{"color", "pink"}}},
+		{Meta: 7, Properties: []Property{{"color", "gray"}}},
+		{Meta: 8, Properties: []Property{{"color", "silver"}}},
+		{Meta: 9, Properties: []Property{{"color", "cyan"}}},
+		{Meta: 10, Properties: []Property{{"color", "purple"}}},
+		{Meta: 11, Properties: []Property{{"color", "blue"}}},
+		{Meta: 12, Properties: []Property{{"color", "brown"}}},
+		{Meta: 13, Properties: []Property{{"color", "green"}}},
+		{Meta: 14, Properties: []Property{{"color", "red"}}},
+		{Meta: 15, Properties: []Property{{"color", "black"}}},
+	}},
+	{ID: 36, Name: "minecraft:piston_extension", Hardness: -1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"type", "normal"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}, {"type", "normal"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"type", "normal"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"type", "normal"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}, {"type", "normal"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}, {"type", "normal"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"type", "sticky"}}},
+		{Meta: 9, Properties: []Property{{"facing", "up"}, {"type", "sticky"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"type", "sticky"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"type", "sticky"}}},
+		{Meta: 12, Properties: []Property{{"facing", "west"}, {"type", "sticky"}}},
+		{Meta: 13, Properties: []Property{{"facing", "east"}, {"type", "sticky"}}},
+	}},
+	{ID: 37, Name: "minecraft:yellow_flower", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"type", "dandelion"}}},
+	}},
+	{ID: 38, Name: "minecraft:red_flower", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"type", "poppy"}}},
+		{Meta: 1, Properties: []Property{{"type", "blue_orchid"}}},
+		{Meta: 2, Properties: []Property{{"type", "allium"}}},
+		{Meta: 3, Properties: []Property{{"type", "houstonia"}}},
+		{Meta: 4, Properties: []Property{{"type", "red_tulip"}}},
+		{Meta: 5, Properties: []Property{{"type", "orange_tulip"}}},
+		{Meta: 6, Properties: []Property{{"type", "white_tulip"}}},
+		{Meta: 7, Properties: []Property{{"type", "pink_tulip"}}},
+		{Meta: 8, Properties: []Property{{"type", "oxeye_daisy"}}},
+	}},
+	{ID: 39, Name: "minecraft:brown_mushroom", Hardness: 0, Light: 1, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 40, Name: "minecraft:red_mushroom", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 41, Name: "minecraft:gold_block", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 42, Name: "minecraft:iron_block", Hardness: 5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 43, Name: "minecraft:double_stone_slab", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"seamless", "false"}, {"variant", "stone"}}},
+		{Meta: 1, Properties: []Property{{"seamless", "false"}, {"variant", "sandstone"}}},
+		{Meta: 2, Properties: []Property{{"seamless", "false"}, {"variant", "wood_old"}}},
+		{Meta: 3, Properties: []Property{{"seamless", "false"}, {"variant", "cobblestone"}}},
+		{Meta: 4, Properties: []Property{{"seamless", "false"}, {"variant", "brick"}}},
+		{Meta: 5, Properties: []Property{{"seamless", "false"}, {"variant", "stone_brick"}}},
+		{Meta: 6, Properties: []Property{{"seamless", "false"}, {"variant", "nether_brick"}}},
+		{Meta: 7, Properties: []Property{{"seamless", "false"}, {"variant", "quartz"}}},
+		{Meta: 8, Properties: []Property{{"seamless", "true"}, {"variant", "stone"}}},
+		{Meta: 9, Properties: []Property{{"seamless", "true"}, {"variant", "sandstone"}}},
+		{Meta: 10, Properties: []Property{{"seamless", "true"}, {"variant", "wood_old"}}},
+		{Meta: 11, Properties: []Property{{"seamless", "true"}, {"variant", "cobblestone"}}},
+		{Meta: 12, Properties: []Property{{"seamless", "true"}, {"variant", "brick"}}},
+		{Meta: 13, Properties: []Property{{"seamless", "true"}, {"variant", "stone_brick"}}},
+		{Meta: 14, Properties: []Property{{"seamless", "true"}, {"variant", "nether_brick"}}},
+		{Meta: 15, Properties: []Property{{"seamless", "true"}, {"variant", "quartz"}}},
+	}},
+	{ID: 44, Name: "minecraft:stone_slab", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"half", "bottom"}, {"variant", "stone"}}},
+		{Meta: 1, Properties: []Property{{"half", "bottom"}, {"variant", "sandstone"}}},
+		{Meta: 2, Properties: []Property{{"half", "bottom"}, {"variant", "wood_old"}}},
+		{Meta: 3, Properties: []Property{{"half", "bottom"}, {"variant", "cobblestone"}}},
+		{Meta: 4, Properties: []Property{{"half", "bottom"}, {"variant", "brick"}}},
+		{Meta: 5, Properties: []Property{{"half", "bottom"}, {"variant", "stone_brick"}}},
+		{Meta: 6, Properties: []Property{{"half", "bottom"}, {"variant", "nether_brick"}}},
+		{Meta: 7, Properties: []Property{{"half", "bottom"}, {"variant", "quartz"}}},
+		{Meta: 8, Properties: []Property{{"half", "top"}, {"variant", "stone"}}},
+		{Meta: 9, Properties: []Property{{"half", "top"}, {"variant", "sandstone"}}},
+		{Meta: 10, Properties: []Property{{"half", "top"}, {"variant", "wood_old"}}},
+		{Meta: 11, Properties: []Property{{"half", "top"}, {"variant", "cobblestone"}}},
+		{Meta: 12, Properties: []Property{{"half", "top"}, {"variant", "brick"}}},
+		{Meta: 13, Properties: []Property{{"half", "top"}, {"variant", "stone_brick"}}},
+		{Meta: 14, Properties: []Property{{"half", "top"}, {"variant", "nether_brick"}}},
+		{Meta: 15, Properties: []Property{{"half", "top"}, {"variant", "quartz"}}},
+	}},
+	{ID: 45, Name: "minecraft:brick_block", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 46, Name: "minecraft:tnt", Hardness: 0, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"explode", "false"}}},
+		{Meta: 1, Properties: []Property{{"explode", "true"}}},
+	}},
+	{ID: 47, Name: "minecraft:bookshelf", Hardness: 1.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 48, Name: "minecraft:mossy_cobblestone", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 49, Name: "minecraft:obsidian", Hardness: 50, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 50, Name: "minecraft:torch", Hardness: 0, Light: 14, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 1, Properties: []Property{{"facing", "east"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}}},
+		{Meta: 5, Properties: []Property{{"facing", "up"}}},
+	}},
+	{ID: 51, Name: "minecraft:fire", Hardness: 0, Light: 15, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 8, Properties: []Property{{"age", "8"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 9, Properties: []Property{{"age", "9"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 10, Properties: []Property{{"age", "10"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 11, Properties: []Property{{"age", "11"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 12, Properties: []Property{{"age", "12"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 13, Properties: []Property{{"age", "13"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 14, Properties: []Property{{"age", "14"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 15, Properties: []Property{{"age", "15"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+	}},
+	{ID: 52, Name: "minecraft:mob_spawner", Hardness: 5, Light: 0, Opacity: 0, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 53, Name: "minecraft:oak_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 54, Name: "minecraft:chest", Hardness: 2.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 55, Name: "minecraft:redstone_wire", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "0"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 1, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "1"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 2, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "2"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 3, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "3"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 4, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "4"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 5, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "5"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 6, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "6"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 7, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "7"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 8, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "8"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 9, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "9"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 10, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "10"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 11, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "11"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 12, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "12"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 13, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "13"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 14, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "14"}, {"south", "none"}, {"west", "none"}}},
+		{Meta: 15, Properties: []Property{{"east", "none"}, {"north", "none"}, {"power", "15"}, {"south", "none"}, {"west", "none"}}},
+	}},
+	{ID: 56, Name: "minecraft:diamond_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 57, Name: "minecraft:diamond_block", Hardness: 5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 58, Name: "minecraft:crafting_table", Hardness: 2.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 59, Name: "minecraft:wheat", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}}},
+	}},
+	{ID: 60, Name: "minecraft:farmland", Hardness: 0.6, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"moisture", "0"}}},
+		{Meta: 1, Properties: []Property{{"moisture", "1"}}},
+		{Meta: 2, Properties: []Property{{"moisture", "2"}}},
+		{Meta: 3, Properties: []Property{{"moisture", "3"}}},
+		{Meta: 4, Properties: []Property{{"moisture", "4"}}},
+		{Meta: 5, Properties: []Property{{"moisture", "5"}}},
+		{Meta: 6, Properties: []Property{{"moisture", "6"}}},
+		{Meta: 7, Properties: []Property{{"moisture", "7"}}},
+	}},
+	{ID: 61, Name: "minecraft:furnace", Hardness: 3.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 62, Name: "minecraft:lit_furnace", Hardness: 3.5, Light: 13, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 63, Name: "minecraft:standing_sign", Hardness: 1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"rotation", "0"}}},
+		{Meta: 1, Properties: []Property{{"rotation", "1"}}},
+		{Meta: 2, Properties: []Property{{"rotation", "2"}}},
+		{Meta: 3, Properties: []Property{{"rotation", "3"}}},
+		{Meta: 4, Properties: []Property{{"rotation", "4"}}},
+		{Meta: 5, Properties: []Property{{"rotation", "5"}}},
+		{Meta: 6, Properties: []Property{{"rotation", "6"}}},
+		{Meta: 7, Properties: []Property{{"rotation", "7"}}},
+		{Meta: 8, Properties: []Property{{"rotation", "8"}}},
+		{Meta: 9, Properties: []Property{{"rotation", "9"}}},
+		{Meta: 10, Properties: []Property{{"rotation", "10"}}},
+		{Meta: 11, Properties: []Property{{"rotation", "11"}}},
+		{Meta: 12, Properties: []Property{{"rotation", "12"}}},
+		{Meta: 13, Properties: []Property{{"rotation", "13"}}},
+		{Meta: 14, Properties: []Property{{"rotation", "14"}}},
+		{Meta: 15, Properties: []Property{{"rotation", "15"}}},
+	}},
+	{ID: 64, Name: "minecraft:wooden_door", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "true"}}},
+	}},
+	{ID: 65, Name: "minecraft:ladder", Hardness: 0.4, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 66, Name: "minecraft:rail", Hardness: 0.7, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"shape", "north_south"}}},
+		{Meta: 1, Properties: []Property{{"shape", "east_west"}}},
+		{Meta: 2, Properties: []Property{{"shape", "ascending_east"}}},
+		{Meta: 3, Properties: []Property{{"shape", "ascending_west"}}},
+		{Meta: 4, Properties: []Property{{"shape", "ascending_north"}}},
+		{Meta: 5, Properties: []Property{{"shape", "ascending_south"}}},
+		{Meta: 6, Properties: []Property{{"shape", "south_east"}}},
+		{Meta: 7, Properties: []Property{{"shape", "south_west"}}},
+		{Meta: 8, Properties: []Property{{"shape", "north_west"}}},
+		{Meta: 9, Properties: []Property{{"shape", "north_east"}}},
+	}},
+	{ID: 67, Name: "minecraft:stone_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 68, Name: "minecraft:wall_sign", Hardness: 1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 69, Name: "minecraft:lever", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down_x"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "east"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "up_z"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "up_x"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "down_z"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down_x"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "east"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "west"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "north"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "up_z"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "up_x"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "down_z"}, {"powered", "true"}}},
+	}},
+	{ID: 70, Name: "minecraft:stone_pressure_plate", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"powered", "true"}}},
+	}},
+	{ID: 71, Name: "minecraft:iron_door", Hardness: 5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "true"}}},
+	}},
+	{ID: 72, Name: "minecraft:wooden_pressure_plate", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"powered", "true"}}},
+	}},
+	{ID: 73, Name: "minecraft:redstone_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 74, Name: "minecraft:lit_redstone_ore", Hardness: 3, Light: 9, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 75, Name: "minecraft:unlit_redstone_torch", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 1, Properties: []Property{{"facing", "east"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}}},
+		{Meta: 5, Properties: []Property{{"facing", "up"}}},
+	}},
+	{ID: 76, Name: "minecraft:redstone_torch", Hardness: 0, Light: 7, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 1, Properties: []Property{{"facing", "east"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}}},
+		{Meta: 5, Properties: []Property{{"facing", "up"}}},
+	}},
+	{ID: 77, Name: "minecraft:stone_button", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "east"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "up"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "east"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "west"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "north"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "up"}, {"powered", "true"}}},
+	}},
+	{ID: 78, Name: "minecraft:snow_layer", Hardness: 0.1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"layers", "1"}}},
+		{Meta: 1, Properties: []Property{{"layers", "2"}}},
+		{Meta: 2, Properties: []Property{{"layers", "3"}}},
+		{Meta: 3, Properties: []Property{{"layers", "4"}}},
+		{Meta: 4, Properties: []Property{{"layers", "5"}}},
+		{Meta: 5, Properties: []Property{{"layers", "6"}}},
+		{Meta: 6, Properties: []Property{{"layers", "7"}}},
+		{Meta: 7, Properties: []Property{{"layers", "8"}}},
+	}},
+	{ID: 79, Name: "minecraft:ice", Hardness: 0.5, Light: 0, Opacity: 3, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 80, Name: "minecraft:snow", Hardness: 0.2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 81, Name: "minecraft:cactus", Hardness: 0.4, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}}},
+		{Meta: 8, Properties: []Property{{"age", "8"}}},
+		{Meta: 9, Properties: []Property{{"age", "9"}}},
+		{Meta: 10, Properties: []Property{{"age", "10"}}},
+		{Meta: 11, Properties: []Property{{"age", "11"}}},
+		{Meta: 12, Properties: []Property{{"age", "12"}}},
+		{Meta: 13, Properties: []Property{{"age", "13"}}},
+		{Meta: 14, Properties: []Property{{"age", "14"}}},
+		{Meta: 15, Properties: []Property{{"age", "15"}}},
+	}},
+	{ID: 82, Name: "minecraft:clay", Hardness: 0.6, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 83, Name: "minecraft:reeds", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}}},
+		{Meta: 8, Properties: []Property{{"age", "8"}}},
+		{Meta: 9, Properties: []Property{{"age", "9"}}},
+		{Meta: 10, Properties: []Property{{"age", "10"}}},
+		{Meta: 11, Properties: []Property{{"age", "11"}}},
+		{Meta: 12, Properties: []Property{{"age", "12"}}},
+		{Meta: 13, Properties: []Property{{"age", "13"}}},
+		{Meta: 14, Properties: []Property{{"age", "14"}}},
+		{Meta: 15, Properties: []Property{{"age", "15"}}},
+	}},
+	{ID: 84, Name: "minecraft:jukebox", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"has_record", "false"}}},
+		{Meta: 1, Properties: []Property{{"has_record", "true"}}},
+	}},
+	{ID: 85, Name: "minecraft:fence", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 86, Name: "minecraft:pumpkin", Hardness: 1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 87, Name: "minecraft:netherrack", Hardness: 0.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 88, Name: "minecraft:soul_sand", Hardness: 0.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 89, Name: "minecraft:glowstone", Hardness: 0.3, Light: 15, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 90, Name: "minecraft:portal", Hardness: -1, Light: 11, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 1, Properties: []Property{{"axis", "x"}}},
+		{Meta: 2, Properties: []Property{{"axis", "z"}}},
+	}},
+	{ID: 91, Name: "minecraft:lit_pumpkin", Hardness: 1, Light: 15, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 92, Name: "minecraft:cake", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"bites", "0"}}},
+		{Meta: 1, Properties: []Property{{"bites", "1"}}},
+		{Meta: 2, Properties: []Property{{"bites", "2"}}},
+		{Meta: 3, Properties: []Property{{"bites", "3"}}},
+		{Meta: 4, Properties: []Property{{"bites", "4"}}},
+		{Meta: 5, Properties: []Property{{"bites", "5"}}},
+		{Meta: 6, Properties: []Property{{"bites", "6"}}},
+	}},
+	{ID: 93, Name: "minecraft:unpowered_repeater", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"delay", "1"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 1, Properties: []Property{{"delay", "1"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 2, Properties: []Property{{"delay", "1"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 3, Properties: []Property{{"delay", "1"}, {"facing", "east"}, {"locked", "false"}}},
+		{Meta: 4, Properties: []Property{{"delay", "2"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 5, Properties: []Property{{"delay", "2"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 6, Properties: []Property{{"delay", "2"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 7, Properties: []Property{{"delay", "2"}, {"facing", "east"}, {"locked", "false"}}},
+		{Meta: 8, Properties: []Property{{"delay", "3"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 9, Properties: []Property{{"delay", "3"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 10, Properties: []Property{{"delay", "3"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 11, Properties: []Property{{"delay", "3"}, {"facing", "east"}, {"locked", "false"}}},
+		{Meta: 12, Properties: []Property{{"delay", "4"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 13, Properties: []Property{{"delay", "4"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 14, Properties: []Property{{"delay", "4"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 15, Properties: []Property{{"delay", "4"}, {"facing", "east"}, {"locked", "false"}}},
+	}},
+	{ID: 94, Name: "minecraft:powered_repeater", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"delay", "1"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 1, Properties: []Property{{"delay", "1"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 2, Properties: []Property{{"delay", "1"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 3, Properties: []Property{{"delay", "1"}, {"facing", "east"}, {"locked", "false"}}},
+		{Meta: 4, Properties: []Property{{"delay", "2"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 5, Properties: []Property{{"delay", "2"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 6, Properties: []Property{{"delay", "2"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 7, Properties: []Property{{"delay", "2"}, {"facing", "east"}, {"locked", "false"}}},
+		{Meta: 8, Properties: []Property{{"delay", "3"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 9, Properties: []Property{{"delay", "3"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 10, Properties: []Property{{"delay", "3"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 11, Properties: []Property{{"delay", "3"}, {"facing", "east"}, {"locked", "false"}}},
+		{Meta: 12, Properties: []Property{{"delay", "4"}, {"facing", "south"}, {"locked", "false"}}},
+		{Meta: 13, Properties: []Property{{"delay", "4"}, {"facing", "west"}, {"locked", "false"}}},
+		{Meta: 14, Properties: []Property{{"delay", "4"}, {"facing", "north"}, {"locked", "false"}}},
+		{Meta: 15, Properties: []Property{{"delay", "4"}, {"facing", "east"}, {"locked", "false"}}},
+	}},
+	{ID: 95, Name: "minecraft:stained_glass", Hardness: 0.3, Light: 0, Opacity: 0, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"color", "white"}}},
+		{Meta: 1, Properties: []Property{{"color", "orange"}}},
+		{Meta: 2, Properties: []Property{{"color", "magenta"}}},
+		{Meta: 3, Properties: []Property{{"color", "light_blue"}}},
+		{Meta: 4, Properties: []Property{{"color", "yellow"}}},
+		{Meta: 5, Properties: []Property{{"color", "lime"}}},
+		{Meta: 6, Properties: []Property{{"color", "pink"}}},
+		{Meta: 7, Properties: []Property{{"color", "gray"}}},
+		{Meta: 8, Properties: []Property{{"color", "silver"}}},
+		{Meta: 9, Properties: []Property{{"color", "cyan"}}},
+		{Meta: 10, Properties: []Property{{"color", "purple"}}},
+		{Meta: 11, Properties: []Property{{"color", "blue"}}},
+		{Meta: 12, Properties: []Property{{"color", "brown"}}},
+		{Meta: 13, Properties: []Property{{"color", "green"}}},
+		{Meta: 14, Properties: []Property{{"color", "red"}}},
+		{Meta: 15, Properties: []Property{{"color", "black"}}},
+	}},
+	{ID: 96, Name: "minecraft:trapdoor", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 12, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"open", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"open", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"open", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"open", "true"}}},
+	}},
+	{ID: 97, Name: "minecraft:monster_egg", Hardness: 0.75, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "stone"}}},
+		{Meta: 1, Properties: []Property{{"variant", "cobblestone"}}},
+		{Meta: 2, Properties: []Property{{"variant", "stone_brick"}}},
+		{Meta: 3, Properties: []Property{{"variant", "mossy_brick"}}},
+		{Meta: 4, Properties: []Property{{"variant", "cracked_brick"}}},
+		{Meta: 5, Properties: []Property{{"variant", "chiseled_brick"}}},
+	}},
+	{ID: 98, Name: "minecraft:stonebrick", Hardness: 1.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "stonebrick"}}},
+		{Meta: 1, Properties: []Property{{"variant", "mossy_stonebrick"}}},
+		{Meta: 2, Properties: []Property{{"variant", "cracked_stonebrick"}}},
+		{Meta: 3, Properties: []Property{{"variant", "chiseled_stonebrick"}}},
+	}},
+	{ID: 99, Name: "minecraft:brown_mushroom_block", Hardness: 0.2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "all_inside"}}},
+		{Meta: 1, Properties: []Property{{"variant", "north_west"}}},
+		{Meta: 2, Properties: []Property{{"variant", "north"}}},
+		{Meta: 3, Properties: []Property{{"variant", "north_east"}}},
+		{Meta: 4, Properties: []Property{{"variant", "west"}}},
+		{Meta: 5, Properties: []Property{{"variant", "center"}}},
+		{Meta: 6, Properties: []Property{{"variant", "east"}}},
+		{Meta: 7, Properties: []Property{{"variant", "south_west"}}},
+		{Meta: 8, Properties: []Property{{"variant", "south"}}},
+		{Meta: 9, Properties: []Property{{"variant", "south_east"}}},
+		{Meta: 10, Properties: []Property{{"variant", "stem"}}},
+		{Meta: 14, Properties: []Property{{"variant", "all_outside"}}},
+		{Meta: 15, Properties: []Property{{"variant", "all_stem"}}},
+	}},
+	{ID: 100, Name: "minecraft:red_mushroom_block", Hardness: 0.2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "all_inside"}}},
+		{Meta: 1, Properties: []Property{{"variant", "north_west"}}},
+		{Meta: 2, Properties: []Property{{"variant", "north"}}},
+		{Meta: 3, Properties: []Property{{"variant", "north_east"}}},
+		{Meta: 4, Properties: []Property{{"variant", "west"}}},
+		{Meta: 5, Properties: []Property{{"variant", "center"}}},
+		{Meta: 6, Properties: []Property{{"variant", "east"}}},
+		{Meta: 7, Properties: []Property{{"variant", "south_west"}}},
+		{Meta: 8, Properties: []Property{{"variant", "south"}}},
+		{Meta: 9, Properties: []Property{{"variant", "south_east"}}},
+		{Meta: 10, Properties: []Property{{"variant", "stem"}}},
+		{Meta: 14, Properties: []Property{{"variant", "all_outside"}}},
+		{Meta: 15, Properties: []Property{{"variant", "all_stem"}}},
+	}},
+	{ID: 101, Name: "minecraft:iron_bars", Hardness: 5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 102, Name: "minecraft:glass_pane", Hardness: 0.3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 103, Name: "minecraft:melon_block", Hardness: 1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 104, Name: "minecraft:pumpkin_stem", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}, {"facing", "up"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}, {"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}, {"facing", "up"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}, {"facing", "up"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}, {"facing", "up"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}, {"facing", "up"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}, {"facing", "up"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}, {"facing", "up"}}},
+	}},
+	{ID: 105, Name: "minecraft:melon_stem", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}, {"facing", "up"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}, {"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}, {"facing", "up"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}, {"facing", "up"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}, {"facing", "up"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}, {"facing", "up"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}, {"facing", "up"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}, {"facing", "up"}}},
+	}},
+	{ID: 106, Name: "minecraft:vine", Hardness: 0.2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 1, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "true"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 2, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "true"}}},
+		{Meta: 3, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "true"}, {"up", "false"}, {"west", "true"}}},
+		{Meta: 4, Properties: []Property{{"east", "false"}, {"north", "true"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 5, Properties: []Property{{"east", "false"}, {"north", "true"}, {"south", "true"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 6, Properties: []Property{{"east", "false"}, {"north", "true"}, {"south", "false"}, {"up", "false"}, {"west", "true"}}},
+		{Meta: 7, Properties: []Property{{"east", "false"}, {"north", "true"}, {"south", "true"}, {"up", "false"}, {"west", "true"}}},
+		{Meta: 8, Properties: []Property{{"east", "true"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 9, Properties: []Property{{"east", "true"}, {"north", "false"}, {"south", "true"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 10, Properties: []Property{{"east", "true"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "true"}}},
+		{Meta: 11, Properties: []Property{{"east", "true"}, {"north", "false"}, {"south", "true"}, {"up", "false"}, {"west", "true"}}},
+		{Meta: 12, Properties: []Property{{"east", "true"}, {"north", "true"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 13, Properties: []Property{{"east", "true"}, {"north", "true"}, {"south", "true"}, {"up", "false"}, {"west", "false"}}},
+		{Meta: 14, Properties: []Property{{"east", "true"}, {"north", "true"}, {"south", "false"}, {"up", "false"}, {"west", "true"}}},
+		{Meta: 15, Properties: []Property{{"east", "true"}, {"north", "true"}, {"south", "true"}, {"up", "false"}, {"west", "true"}}},
+	}},
+	{ID: 107, Name: "minecraft:fence_gate", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+	}},
+	{ID: 108, Name: "minecraft:brick_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 109, Name: "minecraft:stone_brick_stairs", Hardness: 1.5, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 110, Name: "minecraft:mycelium", Hardness: 0.6, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"snowy", "false"}}},
+	}},
+	{ID: 111, Name: "minecraft:waterlily", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 112, Name: "minecraft:nether_brick", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 113, Name: "minecraft:nether_brick_fence", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 114, Name: "minecraft:nether_brick_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 115, Name: "minecraft:nether_wart", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+	}},
+	{ID: 116, Name: "minecraft:enchanting_table", Hardness: 5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 117, Name: "minecraft:brewing_stand", Hardness: 0.5, Light: 1, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"has_bottle_0", "false"}, {"has_bottle_1", "false"}, {"has_bottle_2", "false"}}},
+		{Meta: 1, Properties: []Property{{"has_bottle_0", "true"}, {"has_bottle_1", "false"}, {"has_bottle_2", "false"}}},
+		{Meta: 2, Properties: []Property{{"has_bottle_0", "false"}, {"has_bottle_1", "true"}, {"has_bottle_2", "false"}}},
+		{Meta: 3, Properties: []Property{{"has_bottle_0", "true"}, {"has_bottle_1", "true"}, {"has_bottle_2", "false"}}},
+		{Meta: 4, Properties: []Property{{"has_bottle_0", "false"}, {"has_bottle_1", "false"}, {"has_bottle_2", "true"}}},
+		{Meta: 5, Properties: []Property{{"has_bottle_0", "true"}, {"has_bottle_1", "false"}, {"has_bottle_2", "true"}}},
+		{Meta: 6, Properties: []Property{{"has_bottle_0", "false"}, {"has_bottle_1", "true"}, {"has_bottle_2", "true"}}},
+		{Meta: 7, Properties: []Property{{"has_bottle_0", "true"}, {"has_bottle_1", "true"}, {"has_bottle_2", "true"}}},
+	}},
+	{ID: 118, Name: "minecraft:cauldron", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"level", "0"}}},
+		{Meta: 1, Properties: []Property{{"level", "1"}}},
+		{Meta: 2, Properties: []Property{{"level", "2"}}},
+		{Meta: 3, Properties: []Property{{"level", "3"}}},
+	}},
+	{ID: 119, Name: "minecraft:end_portal", Hardness: -1, Light: 15, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 120, Name: "minecraft:end_portal_frame", Hardness: -1, Light: 1, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"eye", "false"}, {"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"eye", "false"}, {"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"eye", "false"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"eye", "false"}, {"facing", "east"}}},
+		{Meta: 4, Properties: []Property{{"eye", "true"}, {"facing", "south"}}},
+		{Meta: 5, Properties: []Property{{"eye", "true"}, {"facing", "west"}}},
+		{Meta: 6, Properties: []Property{{"eye", "true"}, {"facing", "north"}}},
+		{Meta: 7, Properties: []Property{{"eye", "true"}, {"facing", "east"}}},
+	}},
+	{ID: 121, Name: "minecraft:end_stone", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 122, Name: "minecraft:dragon_egg", Hardness: 3, Light: 1, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 123, Name: "minecraft:redstone_lamp", Hardness: 0.3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 124, Name: "minecraft:lit_redstone_lamp", Hardness: 0.3, Light: 15, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 125, Name: "minecraft:double_wooden_slab", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "oak"}}},
+		{Meta: 1, Properties: []Property{{"variant", "spruce"}}},
+		{Meta: 2, Properties: []Property{{"variant", "birch"}}},
+		{Meta: 3, Properties: []Property{{"variant", "jungle"}}},
+		{Meta: 4, Properties: []Property{{"variant", "acacia"}}},
+		{Meta: 5, Properties: []Property{{"variant", "dark_oak"}}},
+	}},
+	{ID: 126, Name: "minecraft:wooden_slab", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"half", "bottom"}, {"variant", "oak"}}},
+		{Meta: 1, Properties: []Property{{"half", "bottom"}, {"variant", "spruce"}}},
+		{Meta: 2, Properties: []Property{{"half", "bottom"}, {"variant", "birch"}}},
+		{Meta: 3, Properties: []Property{{"half", "bottom"}, {"variant", "jungle"}}},
+		{Meta: 4, Properties: []Property{{"half", "bottom"}, {"variant", "acacia"}}},
+		{Meta: 5, Properties: []Property{{"half", "bottom"}, {"variant", "dark_oak"}}},
+		{Meta: 8, Properties: []Property{{"half", "top"}, {"variant", "oak"}}},
+		{Meta: 9, Properties: []Property{{"half", "top"}, {"variant", "spruce"}}},
+		{Meta: 10, Properties: []Property{{"half", "top"}, {"variant", "birch"}}},
+		{Meta: 11, Properties: []Property{{"half", "top"}, {"variant", "jungle"}}},
+		{Meta: 12, Properties: []Property{{"half", "top"}, {"variant", "acacia"}}},
+		{Meta: 13, Properties: []Property{{"half", "top"}, {"variant", "dark_oak"}}},
+	}},
+	{ID: 127, Name: "minecraft:cocoa", Hardness: 0.2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}, {"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"age", "0"}, {"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"age", "0"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"age", "0"}, {"facing", "east"}}},
+		{Meta: 4, Properties: []Property{{"age", "1"}, {"facing", "south"}}},
+		{Meta: 5, Properties: []Property{{"age", "1"}, {"facing", "west"}}},
+		{Meta: 6, Properties: []Property{{"age", "1"}, {"facing", "north"}}},
+		{Meta: 7, Properties: []Property{{"age", "1"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"age", "2"}, {"facing", "south"}}},
+		{Meta: 9, Properties: []Property{{"age", "2"}, {"facing", "west"}}},
+		{Meta: 10, Properties: []Property{{"age", "2"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"age", "2"}, {"facing", "east"}}},
+	}},
+	{ID: 128, Name: "minecraft:sandstone_stairs", Hardness: 0.8, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 129, Name: "minecraft:emerald_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 130, Name: "minecraft:ender_chest", Hardness: 22.5, Light: 7, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 131, Name: "minecraft:tripwire_hook", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"attached", "false"}, {"facing", "south"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"attached", "false"}, {"facing", "west"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"attached", "false"}, {"facing", "north"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"attached", "false"}, {"facing", "east"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"attached", "true"}, {"facing", "south"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"attached", "true"}, {"facing", "west"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"attached", "true"}, {"facing", "north"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"attached", "true"}, {"facing", "east"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"attached", "false"}, {"facing", "south"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"attached", "false"}, {"facing", "west"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"attached", "false"}, {"facing", "north"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"attached", "false"}, {"facing", "east"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"attached", "true"}, {"facing", "south"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"attached", "true"}, {"facing", "west"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"attached", "true"}, {"facing", "north"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"attached", "true"}, {"facing", "east"}, {"powered", "true"}}},
+	}},
+	{ID: 132, Name: "minecraft:tripwire", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"attached", "false"}, {"disarmed", "false"}, {"east", "false"}, {"north", "false"}, {"powered", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 1, Properties: []Property{{"attached", "false"}, {"disarmed", "false"}, {"east", "false"}, {"north", "false"}, {"powered", "true"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 4, Properties: []Property{{"attached", "true"}, {"disarmed", "false"}, {"east", "false"}, {"north", "false"}, {"powered", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 5, Properties: []Property{{"attached", "true"}, {"disarmed", "false"}, {"east", "false"}, {"north", "false"}, {"powered", "true"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 8, Properties: []Property{{"attached", "false"}, {"disarmed", "true"}, {"east", "false"}, {"north", "false"}, {"powered", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 9, Properties: []Property{{"attached", "false"}, {"disarmed", "true"}, {"east", "false"}, {"north", "false"}, {"powered", "true"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 12, Properties: []Property{{"attached", "true"}, {"disarmed", "true"}, {"east", "false"}, {"north", "false"}, {"powered", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 13, Properties: []Property{{"attached", "true"}, {"disarmed", "true"}, {"east", "false"}, {"north", "false"}, {"powered", "true"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 133, Name: "minecraft:emerald_block", Hardness: 5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 134, Name: "minecraft:spruce_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 135, Name: "minecraft:birch_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 136, Name: "minecraft:jungle_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 137, Name: "minecraft:command_block", Hardness: -1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"conditional", "false"}, {"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"conditional", "false"}, {"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"conditional", "false"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"conditional", "false"}, {"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"conditional", "false"}, {"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"conditional", "false"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"conditional", "true"}, {"facing", "down"}}},
+		{Meta: 9, Properties: []Property{{"conditional", "true"}, {"facing", "up"}}},
+		{Meta: 10, Properties: []Property{{"conditional", "true"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"conditional", "true"}, {"facing", "south"}}},
+		{Meta: 12, Properties: []Property{{"conditional", "true"}, {"facing", "west"}}},
+		{Meta: 13, Properties: []Property{{"conditional", "true"}, {"facing", "east"}}},
+	}},
+	{ID: 138, Name: "minecraft:beacon", Hardness: 3, Light: 15, Opacity: 0, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 139, Name: "minecraft:cobblestone_wall", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"variant", "cobblestone"}, {"west", "false"}}},
+		{Meta: 1, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"variant", "mossy_cobblestone"}, {"west", "false"}}},
+	}},
+	{ID: 140, Name: "minecraft:flower_pot", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"contents", "empty"}, {"legacy_data", "0"}}},
+		{Meta: 1, Properties: []Property{{"contents", "empty"}, {"legacy_data", "1"}}},
+		{Meta: 2, Properties: []Property{{"contents", "empty"}, {"legacy_data", "2"}}},
+		{Meta: 3, Properties: []Property{{"contents", "empty"}, {"legacy_data", "3"}}},
+		{Meta: 4, Properties: []Property{{"contents", "empty"}, {"legacy_data", "4"}}},
+		{Meta: 5, Properties: []Property{{"contents", "empty"}, {"legacy_data", "5"}}},
+		{Meta: 6, Properties: []Property{{"contents", "empty"}, {"legacy_data", "6"}}},
+		{Meta: 7, Properties: []Property{{"contents", "empty"}, {"legacy_data", "7"}}},
+		{Meta: 8, Properties: []Property{{"contents", "empty"}, {"legacy_data", "8"}}},
+		{Meta: 9, Properties: []Property{{"contents", "empty"}, {"legacy_data", "9"}}},
+		{Meta: 10, Properties: []Property{{"contents", "empty"}, {"legacy_data", "10"}}},
+		{Meta: 11, Properties: []Property{{"contents", "empty"}, {"legacy_data", "11"}}},
+		{Meta: 12, Properties: []Property{{"contents", "empty"}, {"legacy_data", "12"}}},
+		{Meta: 13, Properties: []Property{{"contents", "empty"}, {"legacy_data", "13"}}},
+		{Meta: 14, Properties: []Property{{"contents", "empty"}, {"legacy_data", "14"}}},
+		{Meta: 15, Properties: []Property{{"contents", "empty"}, {"legacy_data", "15"}}},
+	}},
+	{ID: 141, Name: "minecraft:carrots", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}}},
+	}},
+	{ID: 142, Name: "minecraft:potatoes", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}}},
+		{Meta: 6, Properties: []Property{{"age", "6"}}},
+		{Meta: 7, Properties: []Property{{"age", "7"}}},
+	}},
+	{ID: 143, Name: "minecraft:wooden_button", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "east"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "up"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "east"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "west"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "north"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "up"}, {"powered", "true"}}},
+	}},
+	{ID: 144, Name: "minecraft:skull", Hardness: 1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"nodrop", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}, {"nodrop", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"nodrop", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"nodrop", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}, {"nodrop", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}, {"nodrop", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"nodrop", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "up"}, {"nodrop", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"nodrop", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"nodrop", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "west"}, {"nodrop", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "east"}, {"nodrop", "true"}}},
+	}},
+	{ID: 145, Name: "minecraft:anvil", Hardness: 5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"damage", "0"}, {"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"damage", "0"}, {"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"damage", "0"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"damage", "0"}, {"facing", "east"}}},
+		{Meta: 4, Properties: []Property{{"damage", "1"}, {"facing", "south"}}},
+		{Meta: 5, Properties: []Property{{"damage", "1"}, {"facing", "west"}}},
+		{Meta: 6, Properties: []Property{{"damage", "1"}, {"facing", "north"}}},
+		{Meta: 7, Properties: []Property{{"damage", "1"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"damage", "2"}, {"facing", "south"}}},
+		{Meta: 9, Properties: []Property{{"damage", "2"}, {"facing", "west"}}},
+		{Meta: 10, Properties: []Property{{"damage", "2"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"damage", "2"}, {"facing", "east"}}},
+	}},
+	{ID: 146, Name: "minecraft:trapped_chest", Hardness: 2.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 147, Name: "minecraft:light_weighted_pressure_plate", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"power", "0"}}},
+		{Meta: 1, Properties: []Property{{"power", "1"}}},
+		{Meta: 2, Properties: []Property{{"power", "2"}}},
+		{Meta: 3, Properties: []Property{{"power", "3"}}},
+		{Meta: 4, Properties: []Property{{"power", "4"}}},
+		{Meta: 5, Properties: []Property{{"power", "5"}}},
+		{Meta: 6, Properties: []Property{{"power", "6"}}},
+		{Meta: 7, Properties: []Property{{"power", "7"}}},
+		{Meta: 8, Properties: []Property{{"power", "8"}}},
+		{Meta: 9, Properties: []Property{{"power", "9"}}},
+		{Meta: 10, Properties: []Property{{"power", "10"}}},
+		{Meta: 11, Properties: []Property{{"power", "11"}}},
+		{Meta: 12, Properties: []Property{{"power", "12"}}},
+		{Meta: 13, Properties: []Property{{"power", "13"}}},
+		{Meta: 14, Properties: []Property{{"power", "14"}}},
+		{Meta: 15, Properties: []Property{{"power", "15"}}},
+	}},
+	{ID: 148, Name: "minecraft:heavy_weighted_pressure_plate", Hardness: 0.5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"power", "0"}}},
+		{Meta: 1, Properties: []Property{{"power", "1"}}},
+		{Meta: 2, Properties: []Property{{"power", "2"}}},
+		{Meta: 3, Properties: []Property{{"power", "3"}}},
+		{Meta: 4, Properties: []Property{{"power", "4"}}},
+		{Meta: 5, Properties: []Property{{"power", "5"}}},
+		{Meta: 6, Properties: []Property{{"power", "6"}}},
+		{Meta: 7, Properties: []Property{{"power", "7"}}},
+		{Meta: 8, Properties: []Property{{"power", "8"}}},
+		{Meta: 9, Properties: []Property{{"power", "9"}}},
+		{Meta: 10, Properties: []Property{{"power", "10"}}},
+		{Meta: 11, Properties: []Property{{"power", "11"}}},
+		{Meta: 12, Properties: []Property{{"power", "12"}}},
+		{Meta: 13, Properties: []Property{{"power", "13"}}},
+		{Meta: 14, Properties: []Property{{"power", "14"}}},
+		{Meta: 15, Properties: []Property{{"power", "15"}}},
+	}},
+	{ID: 149, Name: "minecraft:unpowered_comparator", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"mode", "subtract"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"mode", "subtract"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"mode", "subtract"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"mode", "subtract"}, {"powered", "true"}}},
+	}},
+	{ID: 150, Name: "minecraft:powered_comparator", Hardness: 0, Light: 9, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"mode", "compare"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"mode", "subtract"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"mode", "compare"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"mode", "subtract"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"mode", "subtract"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"mode", "subtract"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"mode", "subtract"}, {"powered", "true"}}},
+	}},
+	{ID: 151, Name: "minecraft:daylight_detector", Hardness: 0.2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"power", "0"}}},
+		{Meta: 1, Properties: []Property{{"power", "1"}}},
+		{Meta: 2, Properties: []Property{{"power", "2"}}},
+		{Meta: 3, Properties: []Property{{"power", "3"}}},
+		{Meta: 4, Properties: []Property{{"power", "4"}}},
+		{Meta: 5, Properties: []Property{{"power", "5"}}},
+		{Meta: 6, Properties: []Property{{"power", "6"}}},
+		{Meta: 7, Properties: []Property{{"power", "7"}}},
+		{Meta: 8, Properties: []Property{{"power", "8"}}},
+		{Meta: 9, Properties: []Property{{"power", "9"}}},
+		{Meta: 10, Properties: []Property{{"power", "10"}}},
+		{Meta: 11, Properties: []Property{{"power", "11"}}},
+		{Meta: 12, Properties: []Property{{"power", "12"}}},
+		{Meta: 13, Properties: []Property{{"power", "13"}}},
+		{Meta: 14, Properties: []Property{{"power", "14"}}},
+		{Meta: 15, Properties: []Property{{"power", "15"}}},
+	}},
+	{ID: 152, Name: "minecraft:redstone_block", Hardness: 5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 153, Name: "minecraft:quartz_ore", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 154, Name: "minecraft:hopper", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"enabled", "true"}, {"facing", "down"}}},
+		{Meta: 2, Properties: []Property{{"enabled", "true"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"enabled", "true"}, {"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"enabled", "true"}, {"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"enabled", "true"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"enabled", "false"}, {"facing", "down"}}},
+		{Meta: 10, Properties: []Property{{"enabled", "false"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"enabled", "false"}, {"facing", "south"}}},
+		{Meta: 12, Properties: []Property{{"enabled", "false"}, {"facing", "west"}}},
+		{Meta: 13, Properties: []Property{{"enabled", "false"}, {"facing", "east"}}},
+	}},
+	{ID: 155, Name: "minecraft:quartz_block", Hardness: 0.8, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "default"}}},
+		{Meta: 1, Properties: []Property{{"variant", "chiseled"}}},
+		{Meta: 2, Properties: []Property{{"variant", "lines_y"}}},
+		{Meta: 3, Properties: []Property{{"variant", "lines_x"}}},
+		{Meta: 4, Properties: []Property{{"variant", "lines_z"}}},
+	}},
+	{ID: 156, Name: "minecraft:quartz_stairs", Hardness: 0.8, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 157, Name: "minecraft:activator_rail", Hardness: 0.7, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"powered", "false"}, {"shape", "north_south"}}},
+		{Meta: 1, Properties: []Property{{"powered", "false"}, {"shape", "east_west"}}},
+		{Meta: 2, Properties: []Property{{"powered", "false"}, {"shape", "ascending_east"}}},
+		{Meta: 3, Properties: []Property{{"powered", "false"}, {"shape", "ascending_west"}}},
+		{Meta: 4, Properties: []Property{{"powered", "false"}, {"shape", "ascending_north"}}},
+		{Meta: 5, Properties: []Property{{"powered", "false"}, {"shape", "ascending_south"}}},
+		{Meta: 8, Properties: []Property{{"powered", "true"}, {"shape", "north_south"}}},
+		{Meta: 9, Properties: []Property{{"powered", "true"}, {"shape", "east_west"}}},
+		{Meta: 10, Properties: []Property{{"powered", "true"}, {"shape", "ascending_east"}}},
+		{Meta: 11, Properties: []Property{{"powered", "true"}, {"shape", "ascending_west"}}},
+		{Meta: 12, Properties: []Property{{"powered", "true"}, {"shape", "ascending_north"}}},
+		{Meta: 13, Properties: []Property{{"powered", "true"}, {"shape", "ascending_south"}}},
+	}},
+	{ID: 158, Name: "minecraft:dropper", Hardness: 3.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"triggered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}, {"triggered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"triggered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"triggered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}, {"triggered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}, {"triggered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"triggered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "up"}, {"triggered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"triggered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"triggered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "west"}, {"triggered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "east"}, {"triggered", "true"}}},
+	}},
+	{ID: 159, Name: "minecraft:stained_hardened_clay", Hardness: 1.25, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"color", "white"}}},
+		{Meta: 1, Properties: []Property{{"color", "orange"}}},
+		{Meta: 2, Properties: []Property{{"color", "magenta"}}},
+		{Meta: 3, Properties: []Property{{"color", "light_blue"}}},
+		{Meta: 4, Properties: []Property{{"color", "yellow"}}},
+		{Meta: 5, Properties: []Property{{"color", "lime"}}},
+		{Meta: 6, Properties: []Property{{"color", "pink"}}},
+		{Meta: 7, Properties: []Property{{"color", "gray"}}},
+		{Meta: 8, Properties: []Property{{"color", "silver"}}},
+		{Meta: 9, Properties: []Property{{"color", "cyan"}}},
+		{Meta: 10, Properties: []Property{{"color", "purple"}}},
+		{Meta: 11, Properties: []Property{{"color", "blue"}}},
+		{Meta: 12, Properties: []Property{{"color", "brown"}}},
+		{Meta: 13, Properties: []Property{{"color", "green"}}},
+		{Meta: 14, Properties: []Property{{"color", "red"}}},
+		{Meta: 15, Properties: []Property{{"color", "black"}}},
+	}},
+	{ID: 160, Name: "minecraft:stained_glass_pane", Hardness: 0.3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"color", "white"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 1, Properties: []Property{{"color", "orange"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 2, Properties: []Property{{"color", "magenta"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 3, Properties: []Property{{"color", "light_blue"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 4, Properties: []Property{{"color", "yellow"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 5, Properties: []Property{{"color", "lime"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 6, Properties: []Property{{"color", "pink"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 7, Properties: []Property{{"color", "gray"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 8, Properties: []Property{{"color", "silver"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 9, Properties: []Property{{"color", "cyan"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 10, Properties: []Property{{"color", "purple"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 11, Properties: []Property{{"color", "blue"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 12, Properties: []Property{{"color", "brown"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 13, Properties: []Property{{"color", "green"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 14, Properties: []Property{{"color", "red"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+		{Meta: 15, Properties: []Property{{"color", "black"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 161, Name: "minecraft:leaves2", Hardness: 0.2, Light: 0, Opacity: 1, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"check_decay", "false"}, {"decayable", "true"}, {"variant", "acacia"}}},
+		{Meta: 1, Properties: []Property{{"check_decay", "false"}, {"decayable", "true"}, {"variant", "dark_oak"}}},
+		{Meta: 4, Properties: []Property{{"check_decay", "false"}, {"decayable", "false"}, {"variant", "acacia"}}},
+		{Meta: 5, Properties: []Property{{"check_decay", "false"}, {"decayable", "false"}, {"variant", "dark_oak"}}},
+		{Meta: 8, Properties: []Property{{"check_decay", "true"}, {"decayable", "true"}, {"variant", "acacia"}}},
+		{Meta: 9, Properties: []Property{{"check_decay", "true"}, {"decayable", "true"}, {"variant", "dark_oak"}}},
+		{Meta: 12, Properties: []Property{{"check_decay", "true"}, {"decayable", "false"}, {"variant", "acacia"}}},
+		{Meta: 13, Properties: []Property{{"check_decay", "true"}, {"decayable", "false"}, {"variant", "dark_oak"}}},
+	}},
+	{ID: 162, Name: "minecraft:log2", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"axis", "y"}, {"variant", "acacia"}}},
+		{Meta: 1, Properties: []Property{{"axis", "y"}, {"variant", "dark_oak"}}},
+		{Meta: 4, Properties: []Property{{"axis", "x"}, {"variant", "acacia"}}},
+		{Meta: 5, Properties: []Property{{"axis", "x"}, {"variant", "dark_oak"}}},
+		{Meta: 8, Properties: []Property{{"axis", "z"}, {"variant", "acacia"}}},
+		{Meta: 9, Properties: []Property{{"axis", "z"}, {"variant", "dark_oak"}}},
+		{Meta: 12, Properties: []Property{{"axis", "none"}, {"variant", "acacia"}}},
+		{Meta: 13, Properties: []Property{{"axis", "none"}, {"variant", "dark_oak"}}},
+	}},
+	{ID: 163, Name: "minecraft:acacia_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 164, Name: "minecraft:dark_oak_stairs", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 165, Name: "minecraft:slime", Hardness: 0, Light: 0, Opacity: 0, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 166, Name: "minecraft:barrier", Hardness: -1, Light: 0, Opacity: 0, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 167, Name: "minecraft:iron_trapdoor", Hardness: 5, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"open", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"open", "true"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"open", "false"}}},
+		{Meta: 12, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"open", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"open", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"open", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"open", "true"}}},
+	}},
+	{ID: 168, Name: "minecraft:prismarine", Hardness: 1.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "prismarine"}}},
+		{Meta: 1, Properties: []Property{{"variant", "prismarine_bricks"}}},
+		{Meta: 2, Properties: []Property{{"variant", "dark_prismarine"}}},
+	}},
+	{ID: 169, Name: "minecraft:sea_lantern", Hardness: 0.3, Light: 15, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 170, Name: "minecraft:hay_block", Hardness: 0.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"axis", "y"}}},
+		{Meta: 4, Properties: []Property{{"axis", "x"}}},
+		{Meta: 8, Properties: []Property{{"axis", "z"}}},
+	}},
+	{ID: 171, Name: "minecraft:carpet", Hardness: 0.1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"color", "white"}}},
+		{Meta: 1, Properties: []Property{{"color", "orange"}}},
+		{Meta: 2, Properties: []Property{{"color", "magenta"}}},
+		{Meta: 3, Properties: []Property{{"color", "light_blue"}}},
+		{Meta: 4, Properties: []Property{{"color", "yellow"}}},
+		{Meta: 5, Properties: []Property{{"color", "lime"}}},
+		{Meta: 6, Properties: []Property{{"color", "pink"}}},
+		{Meta: 7, Properties: []Property{{"color", "gray"}}},
+		{Meta: 8, Properties: []Property{{"color", "silver"}}},
+		{Meta: 9, Properties: []Property{{"color", "cyan"}}},
+		{Meta: 10, Properties: []Property{{"color", "purple"}}},
+		{Meta: 11, Properties: []Property{{"color", "blue"}}},
+		{Meta: 12, Properties: []Property{{"color", "brown"}}},
+		{Meta: 13, Properties: []Property{{"color", "green"}}},
+		{Meta: 14, Properties: []Property{{"color", "red"}}},
+		{Meta: 15, Properties: []Property{{"color", "black"}}},
+	}},
+	{ID: 172, Name: "minecraft:hardened_clay", Hardness: 1.25, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 173, Name: "minecraft:coal_block", Hardness: 5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 174, Name: "minecraft:packed_ice", Hardness: 0.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 175, Name: "minecraft:double_plant", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"variant", "sunflower"}}},
+		{Meta: 1, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"variant", "syringa"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"variant", "double_grass"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"variant", "double_fern"}}},
+		{Meta: 4, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"variant", "double_rose"}}},
+		{Meta: 5, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"variant", "paeonia"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"variant", "sunflower"}}},
+	}},
+	{ID: 176, Name: "minecraft:standing_banner", Hardness: 1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"rotation", "0"}}},
+		{Meta: 1, Properties: []Property{{"rotation", "1"}}},
+		{Meta: 2, Properties: []Property{{"rotation", "2"}}},
+		{Meta: 3, Properties: []Property{{"rotation", "3"}}},
+		{Meta: 4, Properties: []Property{{"rotation", "4"}}},
+		{Meta: 5, Properties: []Property{{"rotation", "5"}}},
+		{Meta: 6, Properties: []Property{{"rotation", "6"}}},
+		{Meta: 7, Properties: []Property{{"rotation", "7"}}},
+		{Meta: 8, Properties: []Property{{"rotation", "8"}}},
+		{Meta: 9, Properties: []Property{{"rotation", "9"}}},
+		{Meta: 10, Properties: []Property{{"rotation", "10"}}},
+		{Meta: 11, Properties: []Property{{"rotation", "11"}}},
+		{Meta: 12, Properties: []Property{{"rotation", "12"}}},
+		{Meta: 13, Properties: []Property{{"rotation", "13"}}},
+		{Meta: 14, Properties: []Property{{"rotation", "14"}}},
+		{Meta: 15, Properties: []Property{{"rotation", "15"}}},
+	}},
+	{ID: 177, Name: "minecraft:wall_banner", Hardness: 1, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 178, Name: "minecraft:daylight_detector_inverted", Hardness: 0.2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"power", "0"}}},
+		{Meta: 1, Properties: []Property{{"power", "1"}}},
+		{Meta: 2, Properties: []Property{{"power", "2"}}},
+		{Meta: 3, Properties: []Property{{"power", "3"}}},
+		{Meta: 4, Properties: []Property{{"power", "4"}}},
+		{Meta: 5, Properties: []Property{{"power", "5"}}},
+		{Meta: 6, Properties: []Property{{"power", "6"}}},
+		{Meta: 7, Properties: []Property{{"power", "7"}}},
+		{Meta: 8, Properties: []Property{{"power", "8"}}},
+		{Meta: 9, Properties: []Property{{"power", "9"}}},
+		{Meta: 10, Properties: []Property{{"power", "10"}}},
+		{Meta: 11, Properties: []Property{{"power", "11"}}},
+		{Meta: 12, Properties: []Property{{"power", "12"}}},
+		{Meta: 13, Properties: []Property{{"power", "13"}}},
+		{Meta: 14, Properties: []Property{{"power", "14"}}},
+		{Meta: 15, Properties: []Property{{"power", "15"}}},
+	}},
+	{ID: 179, Name: "minecraft:red_sandstone", Hardness: 0.8, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"type", "red_sandstone"}}},
+		{Meta: 1, Properties: []Property{{"type", "chiseled_red_sandstone"}}},
+		{Meta: 2, Properties: []Property{{"type", "smooth_red_sandstone"}}},
+	}},
+	{ID: 180, Name: "minecraft:red_sandstone_stairs", Hardness: 0.8, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 181, Name: "minecraft:double_stone_slab2", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"seamless", "false"}, {"variant", "red_sandstone"}}},
+		{Meta: 8, Properties: []Property{{"seamless", "true"}, {"variant", "red_sandstone"}}},
+	}},
+	{ID: 182, Name: "minecraft:stone_slab2", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"half", "bottom"}, {"variant", "red_sandstone"}}},
+		{Meta: 8, Properties: []Property{{"half", "top"}, {"variant", "red_sandstone"}}},
+	}},
+	{ID: 183, Name: "minecraft:spruce_fence_gate", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+	}},
+	{ID: 184, Name: "minecraft:birch_fence_gate", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+	}},
+	{ID: 185, Name: "minecraft:jungle_fence_gate", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+	}},
+	{ID: 186, Name: "minecraft:dark_oak_fence_gate", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+	}},
+	{ID: 187, Name: "minecraft:acacia_fence_gate", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "south"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "west"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 14, Properties: []Property{{"facing", "north"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+		{Meta: 15, Properties: []Property{{"facing", "east"}, {"in_wall", "false"}, {"open", "true"}, {"powered", "true"}}},
+	}},
+	{ID: 188, Name: "minecraft:spruce_fence", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 189, Name: "minecraft:birch_fence", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 190, Name: "minecraft:jungle_fence", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 191, Name: "minecraft:dark_oak_fence", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 192, Name: "minecraft:acacia_fence", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"east", "false"}, {"north", "false"}, {"south", "false"}, {"west", "false"}}},
+	}},
+	{ID: 193, Name: "minecraft:spruce_door", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "true"}}},
+	}},
+	{ID: 194, Name: "minecraft:birch_door", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "true"}}},
+	}},
+	{ID: 195, Name: "minecraft:jungle_door", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "true"}}},
+	}},
+	{ID: 196, Name: "minecraft:acacia_door", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "true"}}},
+	}},
+	{ID: 197, Name: "minecraft:dark_oak_door", Hardness: 3, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "south"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 6, Properties: []Property{{"facing", "west"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "lower"}, {"hinge", "left"}, {"open", "true"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 9, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "false"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "left"}, {"open", "false"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "north"}, {"half", "upper"}, {"hinge", "right"}, {"open", "false"}, {"powered", "true"}}},
+	}},
+	{ID: 198, Name: "minecraft:end_rod", Hardness: 0, Light: 14, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 199, Name: "minecraft:chorus_plant", Hardness: 0.4, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"down", "false"}, {"east", "false"}, {"north", "false"}, {"south", "false"}, {"up", "false"}, {"west", "false"}}},
+	}},
+	{ID: 200, Name: "minecraft:chorus_flower", Hardness: 0.4, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+		{Meta: 4, Properties: []Property{{"age", "4"}}},
+		{Meta: 5, Properties: []Property{{"age", "5"}}},
+	}},
+	{ID: 201, Name: "minecraft:purpur_block", Hardness: 1.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 202, Name: "minecraft:purpur_pillar", Hardness: 1.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"axis", "y"}}},
+		{Meta: 4, Properties: []Property{{"axis", "x"}}},
+		{Meta: 8, Properties: []Property{{"axis", "z"}}},
+	}},
+	{ID: 203, Name: "minecraft:purpur_stairs", Hardness: 1.5, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "east"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 2, Properties: []Property{{"facing", "south"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 3, Properties: []Property{{"facing", "north"}, {"half", "bottom"}, {"shape", "straight"}}},
+		{Meta: 4, Properties: []Property{{"facing", "east"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 5, Properties: []Property{{"facing", "west"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 6, Properties: []Property{{"facing", "south"}, {"half", "top"}, {"shape", "straight"}}},
+		{Meta: 7, Properties: []Property{{"facing", "north"}, {"half", "top"}, {"shape", "straight"}}},
+	}},
+	{ID: 204, Name: "minecraft:purpur_double_slab", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"variant", "default"}}},
+	}},
+	{ID: 205, Name: "minecraft:purpur_slab", Hardness: 2, Light: 0, Opacity: 255, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"half", "bottom"}, {"variant", "default"}}},
+		{Meta: 8, Properties: []Property{{"half", "top"}, {"variant", "default"}}},
+	}},
+	{ID: 206, Name: "minecraft:end_bricks", Hardness: 0.8, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 207, Name: "minecraft:beetroots", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+	}},
+	{ID: 208, Name: "minecraft:grass_path", Hardness: 0.65, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 209, Name: "minecraft:end_gateway", Hardness: -1, Light: 15, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 210, Name: "minecraft:repeating_command_block", Hardness: -1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"conditional", "false"}, {"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"conditional", "false"}, {"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"conditional", "false"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"conditional", "false"}, {"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"conditional", "false"}, {"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"conditional", "false"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"conditional", "true"}, {"facing", "down"}}},
+		{Meta: 9, Properties: []Property{{"conditional", "true"}, {"facing", "up"}}},
+		{Meta: 10, Properties: []Property{{"conditional", "true"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"conditional", "true"}, {"facing", "south"}}},
+		{Meta: 12, Properties: []Property{{"conditional", "true"}, {"facing", "west"}}},
+		{Meta: 13, Properties: []Property{{"conditional", "true"}, {"facing", "east"}}},
+	}},
+	{ID: 211, Name: "minecraft:chain_command_block", Hardness: -1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"conditional", "false"}, {"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"conditional", "false"}, {"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"conditional", "false"}, {"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"conditional", "false"}, {"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"conditional", "false"}, {"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"conditional", "false"}, {"facing", "east"}}},
+		{Meta: 8, Properties: []Property{{"conditional", "true"}, {"facing", "down"}}},
+		{Meta: 9, Properties: []Property{{"conditional", "true"}, {"facing", "up"}}},
+		{Meta: 10, Properties: []Property{{"conditional", "true"}, {"facing", "north"}}},
+		{Meta: 11, Properties: []Property{{"conditional", "true"}, {"facing", "south"}}},
+		{Meta: 12, Properties: []Property{{"conditional", "true"}, {"facing", "west"}}},
+		{Meta: 13, Properties: []Property{{"conditional", "true"}, {"facing", "east"}}},
+	}},
+	{ID: 212, Name: "minecraft:frosted_ice", Hardness: 0.5, Light: 0, Opacity: 3, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"age", "0"}}},
+		{Meta: 1, Properties: []Property{{"age", "1"}}},
+		{Meta: 2, Properties: []Property{{"age", "2"}}},
+		{Meta: 3, Properties: []Property{{"age", "3"}}},
+	}},
+	{ID: 213, Name: "minecraft:magma", Hardness: 0.5, Light: 3, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 214, Name: "minecraft:nether_wart_block", Hardness: 1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 215, Name: "minecraft:red_nether_brick", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 216, Name: "minecraft:bone_block", Hardness: 2, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"axis", "y"}}},
+		{Meta: 4, Properties: []Property{{"axis", "x"}}},
+		{Meta: 8, Properties: []Property{{"axis", "z"}}},
+	}},
+	{ID: 217, Name: "minecraft:structure_void", Hardness: 0, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0},
+	}},
+	{ID: 218, Name: "minecraft:observer", Hardness: 3, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}, {"powered", "false"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}, {"powered", "false"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}, {"powered", "false"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}, {"powered", "false"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}, {"powered", "false"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}, {"powered", "false"}}},
+		{Meta: 8, Properties: []Property{{"facing", "down"}, {"powered", "true"}}},
+		{Meta: 9, Properties: []Property{{"facing", "up"}, {"powered", "true"}}},
+		{Meta: 10, Properties: []Property{{"facing", "north"}, {"powered", "true"}}},
+		{Meta: 11, Properties: []Property{{"facing", "south"}, {"powered", "true"}}},
+		{Meta: 12, Properties: []Property{{"facing", "west"}, {"powered", "true"}}},
+		{Meta: 13, Properties: []Property{{"facing", "east"}, {"powered", "true"}}},
+	}},
+	{ID: 219, Name: "minecraft:white_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 220, Name: "minecraft:orange_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 221, Name: "minecraft:magenta_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 222, Name: "minecraft:light_blue_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 223, Name: "minecraft:yellow_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 224, Name: "minecraft:lime_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 225, Name: "minecraft:pink_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 226, Name: "minecraft:gray_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 227, Name: "minecraft:silver_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 228, Name: "minecraft:cyan_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 229, Name: "minecraft:purple_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 230, Name: "minecraft:blue_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 231, Name: "minecraft:brown_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 232, Name: "minecraft:green_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 233, Name: "minecraft:red_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 234, Name: "minecraft:black_shulker_box", Hardness: 2, Light: 0, Opacity: 0, Solid: false, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "down"}}},
+		{Meta: 1, Properties: []Property{{"facing", "up"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "south"}}},
+		{Meta: 4, Properties: []Property{{"facing", "west"}}},
+		{Meta: 5, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 235, Name: "minecraft:white_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 236, Name: "minecraft:orange_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 237, Name: "minecraft:magenta_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 238, Name: "minecraft:light_blue_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 239, Name: "minecraft:yellow_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 240, Name: "minecraft:lime_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 241, Name: "minecraft:pink_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 242, Name: "minecraft:gray_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 243, Name: "minecraft:silver_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 244, Name: "minecraft:cyan_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 245, Name: "minecraft:purple_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 246, Name: "minecraft:blue_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 247, Name: "minecraft:brown_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 248, Name: "minecraft:green_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 249, Name: "minecraft:red_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 250, Name: "minecraft:black_glazed_terracotta", Hardness: 1.4, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"facing", "south"}}},
+		{Meta: 1, Properties: []Property{{"facing", "west"}}},
+		{Meta: 2, Properties: []Property{{"facing", "north"}}},
+		{Meta: 3, Properties: []Property{{"facing", "east"}}},
+	}},
+	{ID: 251, Name: "minecraft:concrete", Hardness: 1.8, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"color", "white"}}},
+		{Meta: 1, Properties: []Property{{"color", "orange"}}},
+		{Meta: 2, Properties: []Property{{"color", "magenta"}}},
+		{Meta: 3, Properties: []Property{{"color", "light_blue"}}},
+		{Meta: 4, Properties: []Property{{"color", "yellow"}}},
+		{Meta: 5, Properties: []Property{{"color", "lime"}}},
+		{Meta: 6, Properties: []Property{{"color", "pink"}}},
+		{Meta: 7, Properties: []Property{{"color", "gray"}}},
+		{Meta: 8, Properties: []Property{{"color", "silver"}}},
+		{Meta: 9, Properties: []Property{{"color", "cyan"}}},
+		{Meta: 10, Properties: []Property{{"color", "purple"}}},
+		{Meta: 11, Properties: []Property{{"color", "blue"}}},
+		{Meta: 12, Properties: []Property{{"color", "brown"}}},
+		{Meta: 13, Properties: []Property{{"color", "green"}}},
+		{Meta: 14, Properties: []Property{{"color", "red"}}},
+		{Meta: 15, Properties: []Property{{"color", "black"}}},
+	}},
+	{ID: 252, Name: "minecraft:concrete_powder", Hardness: 0.5, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"color", "white"}}},
+		{Meta: 1, Properties: []Property{{"color", "orange"}}},
+		{Meta: 2, Properties: []Property{{"color", "magenta"}}},
+		{Meta: 3, Properties: []Property{{"color", "light_blue"}}},
+		{Meta: 4, Properties: []Property{{"color", "yellow"}}},
+		{Meta: 5, Properties: []Property{{"color", "lime"}}},
+		{Meta: 6, Properties: []Property{{"color", "pink"}}},
+		{Meta: 7, Properties: []Property{{"color", "gray"}}},
+		{Meta: 8, Properties: []Property{{"color", "silver"}}},
+		{Meta: 9, Properties: []Property{{"color", "cyan"}}},
+		{Meta: 10, Properties: []Property{{"color", "purple"}}},
+		{Meta: 11, Properties: []Property{{"color", "blue"}}},
+		{Meta: 12, Properties: []Property{{"color", "brown"}}},
+		{Meta: 13, Properties: []Property{{"color", "green"}}},
+		{Meta: 14, Properties: []Property{{"color", "red"}}},
+		{Meta: 15, Properties: []Property{{"color", "black"}}},
+	}},
+	{ID: 255, Name: "minecraft:structure_block", Hardness: -1, Light: 0, Opacity: 255, Solid: true, Variants: []BlockVariant{
+		{Meta: 0, Properties: []Property{{"mode", "save"}}},
+		{Meta: 1, Properties: []Property{{"mode", "load"}}},
+		{Meta: 2, Properties: []Property{{"mode", "corner"}}},
+		{Meta: 3, Properties: []Property{{"mode", "data"}}},
+	}},
+}
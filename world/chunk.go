@@ -0,0 +1,592 @@
+package world
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/laushunyu/real/packet"
+	"github.com/laushunyu/real/stream"
+	"github.com/seebs/nbt"
+)
+
+const (
+	SectionCount = 16
+	// blocks in a section
+	sectionVolume = 16 * 16 * 16
+
+	// bits per block of indirect palette are in [minBitsPerBlock, maxBitsPerBlock],
+	// more bits than that will use the global palette with globalBitsPerBlock.
+	minBitsPerBlock    = 4
+	maxBitsPerBlock    = 8
+	globalBitsPerBlock = 13
+)
+
+type ChunkPos struct {
+	X, Z int32
+}
+
+// Section is a 16x16x16 part of chunk, blocks are indexed by y<<8|z<<4|x.
+// Blocks are stored as indexes of palette, or block states directly with the global palette.
+type Section struct {
+	// palette is nil when using the global palette
+	palette []BlockState
+	index   map[BlockState]uint64
+	data    *BitArray
+	// how many blocks of each state, used to compact palette
+	counts map[BlockState]int
+	// some states in palette are not used anymore
+	unused bool
+
+	// BlockLight and SkyLight are nibble arrays, 4 bits per block
+	BlockLight [2048]byte
+	SkyLight   [2048]byte
+}
+
+// NewSection return an empty section.
+// lights are not computed yet, so it is fully lit.
+func NewSection() *Section {
+	s := &Section{
+		palette: []BlockState{Air},
+		index:   map[BlockState]uint64{Air: 0},
+		data:    NewBitArray(minBitsPerBlock, sectionVolume),
+		counts:  map[BlockState]int{Air: sectionVolume},
+	}
+	for i := range s.BlockLight {
+		s.BlockLight[i] = 0xFF
+		s.SkyLight[i] = 0xFF
+	}
+	return s
+}
+
+func sectionIndex(x, y, z int) int {
+	return y<<8 | z<<4 | x
+}
+
+func (s *Section) block(i int) BlockState {
+	v := s.data.Get(i)
+	if s.palette == nil {
+		return BlockState(v)
+	}
+	return s.palette[v]
+}
+
+func (s *Section) Block(x, y, z int) BlockState {
+	return s.block(sectionIndex(x, y, z))
+}
+
+func (s *Section) SetBlock(x, y, z int, state BlockState) {
+	i := sectionIndex(x, y, z)
+	old := s.block(i)
+	if old == state {
+		return
+	}
+
+	s.counts[state]++
+	s.data.Set(i, s.paletteIndex(state))
+
+	s.counts[old]--
+	if s.counts[old] == 0 {
+		delete(s.counts, old)
+		s.unused = true
+	}
+}
+
+// paletteIndex return index of state in palette, add it to palette if not exist.
+func (s *Section) paletteIndex(state BlockState) uint64 {
+	if s.palette == nil {
+		return uint64(state)
+	}
+	if v, ok := s.index[state]; ok {
+		return v
+	}
+
+	if len(s.palette) == 1<<s.data.Bits() {
+		// palette is full, drop unused states or grow bits
+		if s.unused {
+			s.compact()
+		} else {
+			s.resize(s.data.Bits() + 1)
+		}
+		return s.paletteIndex(state)
+	}
+
+	v := uint64(len(s.palette))
+	s.palette = append(s.palette, state)
+	s.index[state] = v
+	return v
+}
+
+// resize rebuild data with bits, switch to the global palette if bits is too many.
+func (s *Section) resize(bits uint8) {
+	blocks := make([]BlockState, sectionVolume)
+	for i := range blocks {
+		blocks[i] = s.block(i)
+	}
+
+	if bits > maxBitsPerBlock {
+		s.palette, s.index = nil, nil
+		s.data = NewBitArray(globalBitsPerBlock, sectionVolume)
+		for i, state := range blocks {
+			s.data.Set(i, uint64(state))
+		}
+		return
+	}
+
+	s.data = NewBitArray(bits, sectionVolume)
+	if s.palette == nil {
+		s.palette, s.index = []BlockState{}, map[BlockState]uint64{}
+	}
+	for i, state := range blocks {
+		v, ok := s.index[state]
+		if !ok {
+			v = uint64(len(s.palette))
+			s.palette = append(s.palette, state)
+			s.index[state] = v
+		}
+		s.data.Set(i, v)
+	}
+}
+
+// compact rebuild palette with only states in use, and use as few bits as possible.
+func (s *Section) compact() {
+	s.unused = false
+
+	bits := uint8(minBitsPerBlock)
+	for len(s.counts) > 1<<bits {
+		bits++
+	}
+
+	blocks := make([]BlockState, sectionVolume)
+	for i := range blocks {
+		blocks[i] = s.block(i)
+	}
+	s.palette, s.index = nil, nil
+	if bits <= maxBitsPerBlock {
+		s.palette, s.index = []BlockState{}, map[BlockState]uint64{}
+		s.data = NewBitArray(bits, sectionVolume)
+	} else {
+		s.data = NewBitArray(globalBitsPerBlock, sectionVolume)
+	}
+	for i, state := range blocks {
+		s.data.Set(i, s.paletteIndex(state))
+	}
+}
+
+// Empty report whether all blocks in section are air.
+func (s *Section) Empty() bool {
+	return s.counts[Air] == sectionVolume
+}
+
+func getNibble(arr []byte, i int) uint8 {
+	return arr[i/2] >> ((i % 2) * 4) & 0xF
+}
+
+func setNibble(arr []byte, i int, v uint8) {
+	shift := (i % 2) * 4
+	arr[i/2] = arr[i/2]&^(0xF<<shift) | (v&0xF)<<shift
+}
+
+func (s *Section) BlockLightAt(x, y, z int) uint8 {
+	return getNibble(s.BlockLight[:], sectionIndex(x, y, z))
+}
+
+func (s *Section) SetBlockLight(x, y, z int, level uint8) {
+	setNibble(s.BlockLight[:], sectionIndex(x, y, z), level)
+}
+
+func (s *Section) SkyLightAt(x, y, z int) uint8 {
+	return getNibble(s.SkyLight[:], sectionIndex(x, y, z))
+}
+
+func (s *Section) SetSkyLight(x, y, z int, level uint8) {
+	setNibble(s.SkyLight[:], sectionIndex(x, y, z), level)
+}
+
+// write section in Chunk Data format, sky light is only sent in dimensions with sky.
+func (s *Section) write(w *stream.Writer, skyLight bool) {
+	if s.unused {
+		s.compact()
+	}
+
+	w.WriteUByte(s.data.Bits())
+	// palette length is still sent as 0 with the global palette
+	w.WriteVarInt(uint64(len(s.palette)))
+	for _, state := range s.palette {
+		w.WriteVarInt(uint64(state))
+	}
+
+	data := s.data.Longs()
+	w.WriteVarInt(uint64(len(data)))
+	for _, v := range data {
+		w.WriteLong(v)
+	}
+
+	w.WriteRaw(s.BlockLight[:])
+	if skyLight {
+		w.WriteRaw(s.SkyLight[:])
+	}
+}
+
+// Chunk is a 16x256x16 column of sections.
+type Chunk struct {
+	X, Z int32
+
+	mu       sync.RWMutex
+	sections [SectionCount]*Section
+	biomes   [256]byte
+	// block entities indexed by y<<8|z<<4|x
+	blockEntities map[int]nbt.Compound
+	// bitmask of sections modified
+	modified uint16
+	// changed since last saved
+	unsaved bool
+	// 1 after light computed by world
+	lit int32
+	// entities saved in chunk, kept as nbt to be saved back
+	entityTags []nbt.Compound
+}
+
+func NewChunk(x, z int32) *Chunk {
+	return &Chunk{
+		X:             x,
+		Z:             z,
+		blockEntities: make(map[int]nbt.Compound),
+	}
+}
+
+// Block return block state at chunk local x, z in [0, 16) and y in [0, 256).
+func (c *Chunk) Block(x, y, z int) BlockState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := c.sections[y>>4]
+	if s == nil {
+		return Air
+	}
+	return s.Block(x, y&0xF, z)
+}
+
+// SetBlock set block state at chunk local x, z in [0, 16) and y in [0, 256).
+func (c *Chunk) SetBlock(x, y, z int, state BlockState) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.sections[y>>4]
+	if s == nil {
+		if state == Air {
+			return
+		}
+		s = c.newSection(y >> 4)
+	}
+	s.SetBlock(x, y&0xF, z, state)
+	c.modified |= 1 << (y >> 4)
+	c.unsaved = true
+}
+
+// newSection create section at index i with lights the client assumes for a missing section:
+// no block light, and full sky light above the height.
+func (c *Chunk) newSection(i int) *Section {
+	s := NewSection()
+	for z := 0; z < 16; z++ {
+		for x := 0; x < 16; x++ {
+			height := c.height(x, z)
+			for y := 0; y < 16; y++ {
+				s.SetBlockLight(x, y, z, 0)
+				if i*16+y < height {
+					s.SetSkyLight(x, y, z, 0)
+				}
+			}
+		}
+	}
+	c.sections[i] = s
+	return s
+}
+
+// Height return y above the highest block which blocks sky light at chunk local x, z.
+func (c *Chunk) Height(x, z int) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.height(x, z)
+}
+
+func (c *Chunk) height(x, z int) int {
+	for i := SectionCount - 1; i >= 0; i-- {
+		s := c.sections[i]
+		if s == nil || s.Empty() {
+			continue
+		}
+		for y := 15; y >= 0; y-- {
+			if b := s.Block(x, y, z).Block(); b != nil && b.Opacity > 0 {
+				return i*16 + y + 1
+			}
+		}
+	}
+	return 0
+}
+
+// BlockEntity return nbt of block entity at chunk local x, y, z, nil if not exists.
+func (c *Chunk) BlockEntity(x, y, z int) nbt.Compound {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.blockEntities[y<<8|z<<4|x]
+}
+
+// SetBlockEntity set block entity at chunk local x, y, z,
+// its x, y, z in tag will be set to the world coordinate.
+func (c *Chunk) SetBlockEntity(x, y, z int, tag nbt.Compound) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tag["x"] = nbt.Int(int(c.X)<<4 | x)
+	tag["y"] = nbt.Int(y)
+	tag["z"] = nbt.Int(int(c.Z)<<4 | z)
+	c.blockEntities[y<<8|z<<4|x] = tag
+	c.unsaved = true
+}
+
+func (c *Chunk) RemoveBlockEntity(x, y, z int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.blockEntities, y<<8|z<<4|x)
+	c.unsaved = true
+}
+
+// BlockEntities return all block entities in chunk.
+func (c *Chunk) BlockEntities() []nbt.Compound {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	tags := make([]nbt.Compound, 0, len(c.blockEntities))
+	for _, tag := range c.blockEntities {
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func (c *Chunk) BlockLight(x, y, z int) uint8 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := c.sections[y>>4]
+	if s == nil {
+		return 0
+	}
+	return s.BlockLightAt(x, y&0xF, z)
+}
+
+func (c *Chunk) SkyLight(x, y, z int) uint8 {
+	return c.Light(LightSky, x, y, z)
+}
+
+// LightKind is sky light or block light.
+type LightKind uint8
+
+const (
+	LightSky LightKind = iota
+	LightBlock
+)
+
+// Light return light of kind at chunk local x, y, z.
+// A missing section has no block light, and full sky light above the height.
+func (c *Chunk) Light(kind LightKind, x, y, z int) uint8 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.light(kind, x, y, z)
+}
+
+func (c *Chunk) light(kind LightKind, x, y, z int) uint8 {
+	s := c.sections[y>>4]
+	switch {
+	case s == nil && kind == LightBlock:
+		return 0
+	case s == nil && y >= c.height(x, z):
+		return 15
+	case s == nil:
+		return 0
+	case kind == LightBlock:
+		return s.BlockLightAt(x, y&0xF, z)
+	default:
+		return s.SkyLightAt(x, y&0xF, z)
+	}
+}
+
+// SetLight set light of kind at chunk local x, y, z, and mark the section modified if changed.
+func (c *Chunk) SetLight(kind LightKind, x, y, z int, level uint8) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.light(kind, x, y, z) == level {
+		return
+	}
+	s := c.sections[y>>4]
+	if s == nil {
+		s = c.newSection(y >> 4)
+	}
+	if kind == LightBlock {
+		s.SetBlockLight(x, y&0xF, z, level)
+	} else {
+		s.SetSkyLight(x, y&0xF, z, level)
+	}
+	c.modified |= 1 << (y >> 4)
+	c.unsaved = true
+}
+
+func (c *Chunk) Biome(x, z int) byte {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.biomes[z<<4|x]
+}
+
+func (c *Chunk) SetBiome(x, z int, biome byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.biomes[z<<4|x] = biome
+	c.unsaved = true
+}
+
+// Biomes return biome ids indexed by z<<4|x.
+func (c *Chunk) Biomes() [256]byte {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.biomes
+}
+
+func (c *Chunk) SetBiomes(biomes [256]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.biomes = biomes
+	c.unsaved = true
+}
+
+// SetSection replace section at index y>>4, nil to remove it.
+func (c *Chunk) SetSection(i int, s *Section) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sections[i] = s
+	c.modified |= 1 << i
+	c.unsaved = true
+}
+
+// EntityTags return nbt of entities stored in chunk.
+func (c *Chunk) EntityTags() []nbt.Compound {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.entityTags
+}
+
+func (c *Chunk) SetEntityTags(tags []nbt.Compound) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entityTags = tags
+	c.unsaved = true
+}
+
+// Section return section at index y>>4, nil if nothing there.
+func (c *Chunk) Section(i int) *Section {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.sections[i]
+}
+
+// EachSection call fn with every non-empty section from bottom to top while chunk is locked,
+// fn must not modify blocks of chunk.
+func (c *Chunk) EachSection(fn func(i int, s *Section)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for i, s := range c.sections {
+		if s != nil && !s.Empty() {
+			fn(i, s)
+		}
+	}
+}
+
+// ChunkDataPacket build a ground up Chunk Data of 1.12 with all non-empty sections,
+// biomes and block entities, skyLight must match dimension of the client.
+func (c *Chunk) ChunkDataPacket(skyLight bool) packet.Packet {
+	// writing sections may compact their palettes
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var bitmask uint16
+	for i, s := range c.sections {
+		if s != nil && !s.Empty() {
+			bitmask |= 1 << i
+		}
+	}
+	return c.chunkDataPacket(bitmask, true, skyLight)
+}
+
+// SectionsPacket build a non ground up Chunk Data with sections in bitmask,
+// sections are sent even if empty so that client clears them.
+func (c *Chunk) SectionsPacket(bitmask uint16, skyLight bool) packet.Packet {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.chunkDataPacket(bitmask, false, skyLight)
+}
+
+// TakeModified return bitmask of sections modified since last call.
+func (c *Chunk) TakeModified() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	modified := c.modified
+	c.modified = 0
+	return modified
+}
+
+// MarkUnsaved mark chunk to be saved, for changes made on sections directly such as light.
+func (c *Chunk) MarkUnsaved() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsaved = true
+}
+
+// Unsaved report whether chunk changed since last saved.
+func (c *Chunk) Unsaved() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.unsaved
+}
+
+// TakeUnsaved report whether chunk changed since last call.
+func (c *Chunk) TakeUnsaved() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	unsaved := c.unsaved
+	c.unsaved = false
+	return unsaved
+}
+
+func (c *Chunk) chunkDataPacket(bitmask uint16, groundUp, skyLight bool) packet.Packet {
+	dataBuf := bytes.NewBuffer(nil)
+	dataWrt := stream.NewWriter(dataBuf)
+
+	for i := range c.sections {
+		if bitmask&(1<<i) == 0 {
+			continue
+		}
+		if c.sections[i] == nil {
+			c.sections[i] = NewSection()
+		}
+		c.sections[i].write(dataWrt, skyLight)
+	}
+	// biomes are only sent with ground up
+	if groundUp {
+		dataWrt.WriteRaw(c.biomes[:])
+	}
+
+	pkt := packet.NewPacket(0x20)
+	pkt.
+		WriteInt(uint32(c.X)).
+		WriteInt(uint32(c.Z)).
+		WriteBoolean(groundUp).
+		WriteVarInt(uint64(bitmask)).
+		WriteVarInt(uint64(dataBuf.Len())).
+		WriteRaw(dataBuf.Bytes())
+
+	// block entities in sections sent
+	var blockEntities []nbt.Compound
+	for i, tag := range c.blockEntities {
+		if bitmask&(1<<(i>>12)) != 0 {
+			blockEntities = append(blockEntities, tag)
+		}
+	}
+	pkt.WriteVarInt(uint64(len(blockEntities)))
+	for _, tag := range blockEntities {
+		pkt.WriteNbt(tag)
+	}
+	return pkt
+}
@@ -0,0 +1,143 @@
+package world
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/laushunyu/real/stream"
+)
+
+// fillSection set blocks of s to n states in turn, state of block i is returned by state(i).
+// States are of block 1 and up with all metas, so they fit in the global palette.
+func fillSection(s *Section, n int) func(i int) BlockState {
+	state := func(i int) BlockState {
+		k := i % n
+		return NewBlockState(uint16(1+k/16), uint8(k%16))
+	}
+	for i := 0; i < sectionVolume; i++ {
+		s.SetBlock(i&0xF, i>>8, i>>4&0xF, state(i))
+	}
+	return state
+}
+
+// readSection decode a section written in Chunk Data format back to blocks,
+// blocks are states directly if palette is empty.
+func readSection(t *testing.T, buf *bytes.Buffer, skyLight bool) (bits uint8, blocks []BlockState) {
+	r := stream.NewReader(buf)
+	bits, _ = r.ReadByte()
+	n, _ := r.ReadVarInt()
+	palette := make([]BlockState, n)
+	for i := range palette {
+		v, _ := r.ReadVarInt()
+		palette[i] = BlockState(v)
+	}
+	longs, _ := r.ReadVarInt()
+	data := NewBitArray(bits, sectionVolume)
+	if int(longs) != len(data.Longs()) {
+		t.Fatalf("%d longs for %d bits", longs, bits)
+	}
+	for i := range data.Longs() {
+		data.Longs()[i], _ = r.ReadLong()
+	}
+	light := 2048
+	if skyLight {
+		light *= 2
+	}
+	if _, err := r.ReadRaw(light); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("%d bytes left after section", buf.Len())
+	}
+
+	blocks = make([]BlockState, sectionVolume)
+	for i := range blocks {
+		v := data.Get(i)
+		if len(palette) == 0 {
+			blocks[i] = BlockState(v)
+		} else {
+			blocks[i] = palette[v]
+		}
+	}
+	return bits, blocks
+}
+
+func TestSectionPalette(t *testing.T) {
+	for _, c := range []struct {
+		states int
+		bits   uint8
+	}{
+		{1, 4},
+		{16, 4},
+		// palette grows while air is still there, air is dropped when written
+		{17, 5},
+		{32, 5},
+		{33, 6},
+		{256, 8},
+		{257, globalBitsPerBlock},
+		{1000, globalBitsPerBlock},
+	} {
+		s := NewSection()
+		state := fillSection(s, c.states)
+		for i := 0; i < sectionVolume; i++ {
+			if got := s.Block(i&0xF, i>>8, i>>4&0xF); got != state(i) {
+				t.Fatalf("%d states: block %d is %v, want %v", c.states, i, got, state(i))
+			}
+		}
+
+		var buf bytes.Buffer
+		s.write(stream.NewWriter(&buf), true)
+		bits, blocks := readSection(t, &buf, true)
+		if bits != c.bits {
+			t.Errorf("%d states: written with %d bits, want %d", c.states, bits, c.bits)
+		}
+		for i, got := range blocks {
+			if got != state(i) {
+				t.Fatalf("%d states: block %d written as %v, want %v", c.states, i, got, state(i))
+			}
+		}
+	}
+}
+
+func TestSectionCompact(t *testing.T) {
+	for _, c := range []struct {
+		states int
+		// states left after the others are replaced by air
+		left int
+		bits uint8
+	}{
+		{20, 3, 4},
+		{100, 20, 5},
+		{1000, 2, 4},
+		{1000, 300, globalBitsPerBlock},
+	} {
+		s := NewSection()
+		state := fillSection(s, c.states)
+		want := make([]BlockState, sectionVolume)
+		for i := range want {
+			want[i] = state(i)
+			if i%c.states >= c.left {
+				want[i] = Air
+				s.SetBlock(i&0xF, i>>8, i>>4&0xF, Air)
+			}
+		}
+
+		var buf bytes.Buffer
+		s.write(stream.NewWriter(&buf), false)
+		bits, blocks := readSection(t, &buf, false)
+		if bits != c.bits {
+			t.Errorf("%d of %d states: compacted to %d bits, want %d", c.left, c.states, bits, c.bits)
+		}
+		for i, got := range blocks {
+			if got != want[i] {
+				t.Fatalf("%d of %d states: block %d is %v after compacted, want %v", c.left, c.states, i, got, want[i])
+			}
+		}
+
+		// palette is full again after compacted, new states still fit
+		s.SetBlock(0, 0, 0, NewBlockState(200, 1))
+		if got := s.Block(0, 0, 0); got != NewBlockState(200, 1) {
+			t.Errorf("%d of %d states: block set after compacted is %v", c.left, c.states, got)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package world
+
+// dimension ids of Join Game and Respawn
+const (
+	DimensionNether    int32 = -1
+	DimensionOverworld int32 = 0
+	DimensionEnd       int32 = 1
+)
+
+// HasSkyLight report whether chunks of w have sky light, only the overworld has sky.
+func (w *World) HasSkyLight() bool {
+	return w.Dimension == DimensionOverworld
+}
@@ -0,0 +1,56 @@
+package world
+
+import (
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/laushunyu/real/stream"
+)
+
+var lastEntityID int32
+
+// NextEntityID allocate a new entity id, it is unique while server running.
+func NextEntityID() int32 {
+	return atomic.AddInt32(&lastEntityID, 1)
+}
+
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+func (v Vec3) Add(o Vec3) Vec3 {
+	return Vec3{v.X + o.X, v.Y + o.Y, v.Z + o.Z}
+}
+
+func (v Vec3) Sub(o Vec3) Vec3 {
+	return Vec3{v.X - o.X, v.Y - o.Y, v.Z - o.Z}
+}
+
+// AABB is an axis aligned bounding box.
+type AABB struct {
+	Min, Max Vec3
+}
+
+// NewAABB return box of width and height standing on pos.
+func NewAABB(pos Vec3, width, height float64) AABB {
+	return AABB{
+		Min: Vec3{pos.X - width/2, pos.Y, pos.Z - width/2},
+		Max: Vec3{pos.X + width/2, pos.Y + height, pos.Z + width/2},
+	}
+}
+
+func (b AABB) Intersects(o AABB) bool {
+	return b.Min.X < o.Max.X && b.Max.X > o.Min.X &&
+		b.Min.Y < o.Max.Y && b.Max.Y > o.Min.Y &&
+		b.Min.Z < o.Max.Z && b.Max.Z > o.Min.Z
+}
+
+type Entity interface {
+	ID() int32
+	UUID() uuid.UUID
+	Position() Vec3
+	Velocity() Vec3
+	BoundingBox() AABB
+	Metadata() *stream.Metadata
+	World() *World
+}
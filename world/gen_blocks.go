@@ -0,0 +1,99 @@
+//go:build ignore
+
+// gen_blocks generate blocks_gen.go from blocks.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+type variant struct {
+	Meta       uint8             `json:"meta"`
+	Properties map[string]string `json:"properties"`
+}
+
+type block struct {
+	ID       uint16    `json:"id"`
+	Name     string    `json:"name"`
+	Hardness float32   `json:"hardness"`
+	Light    uint8     `json:"light"`
+	Opacity  uint8     `json:"opacity"`
+	Solid    bool      `json:"solid"`
+	Variants []variant `json:"variants"`
+}
+
+// constName convert minecraft:flowing_water to BlockFlowingWater.
+func constName(name string) string {
+	name = strings.TrimPrefix(name, "minecraft:")
+	var b strings.Builder
+	b.WriteString("Block")
+	for _, part := range strings.Split(name, "_") {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func main() {
+	raw, err := ioutil.ReadFile("blocks.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var blocks []block
+	if err := json.Unmarshal(raw, &blocks); err != nil {
+		log.Fatal(err)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintln(buf, `// Code generated by "go run gen_blocks.go"; DO NOT EDIT.`)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package world")
+	fmt.Fprintln(buf)
+
+	fmt.Fprintln(buf, "// ids of blocks")
+	fmt.Fprintln(buf, "const (")
+	for _, b := range blocks {
+		fmt.Fprintf(buf, "%s uint16 = %d\n", constName(b.Name), b.ID)
+	}
+	fmt.Fprintln(buf, ")")
+	fmt.Fprintln(buf)
+
+	fmt.Fprintln(buf, "var blocks = []Block{")
+	for _, b := range blocks {
+		fmt.Fprintf(buf, "{ID: %d, Name: %q, Hardness: %v, Light: %d, Opacity: %d, Solid: %v, Variants: []BlockVariant{\n",
+			b.ID, b.Name, b.Hardness, b.Light, b.Opacity, b.Solid)
+		for _, v := range b.Variants {
+			names := make([]string, 0, len(v.Properties))
+			for name := range v.Properties {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			props := make([]string, 0, len(names))
+			for _, name := range names {
+				props = append(props, fmt.Sprintf("{%q, %q}", name, v.Properties[name]))
+			}
+			if len(props) == 0 {
+				fmt.Fprintf(buf, "{Meta: %d},\n", v.Meta)
+			} else {
+				fmt.Fprintf(buf, "{Meta: %d, Properties: []Property{%s}},\n", v.Meta, strings.Join(props, ", "))
+			}
+		}
+		fmt.Fprintln(buf, "}},")
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("blocks_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,89 @@
+package generate
+
+import (
+	"github.com/laushunyu/real/world"
+)
+
+// biome ids of 1.12 used by terrain generator
+const (
+	BiomeOcean        = 0
+	BiomeDesert       = 2
+	BiomeExtremeHills = 3
+	BiomeForest       = 4
+	BiomeTaiga        = 5
+	BiomeSwampland    = 6
+	BiomeBeach        = 16
+	BiomeJungle       = 21
+	BiomeDeepOcean    = 24
+	BiomeBirchForest  = 27
+)
+
+var (
+	stone     = world.MustParseBlockState("minecraft:stone[variant=stone]")
+	dirt      = world.MustParseBlockState("minecraft:dirt[variant=dirt]")
+	grass     = world.MustParseBlockState("minecraft:grass[snowy=false]")
+	sand      = world.MustParseBlockState("minecraft:sand[variant=sand]")
+	sandstone = world.MustParseBlockState("minecraft:sandstone[type=sandstone]")
+	gravel    = world.MustParseBlockState("minecraft:gravel")
+	bedrock   = world.MustParseBlockState("minecraft:bedrock")
+	water     = world.MustParseBlockState("minecraft:water[level=0]")
+	lava      = world.MustParseBlockState("minecraft:lava[level=0]")
+)
+
+// biome decides surface blocks and decoration of columns.
+type biome struct {
+	ID byte
+	// Top is the surface block, Filler is the few blocks under it
+	Top, Filler world.BlockState
+	// Trees is chance of a tree in each column, Grass and Flowers are chances of plants
+	Trees, Grass, Flowers float64
+	// Tree grown in biome
+	Tree treeKind
+	// Cactus replace tree in desert
+	Cactus bool
+}
+
+var biomes = map[byte]*biome{
+	BiomeOcean:        {ID: BiomeOcean, Top: gravel, Filler: gravel},
+	BiomeDeepOcean:    {ID: BiomeDeepOcean, Top: gravel, Filler: gravel},
+	BiomePlains:       {ID: BiomePlains, Top: grass, Filler: dirt, Trees: 0.001, Grass: 0.2, Flowers: 0.02, Tree: treeOak},
+	BiomeDesert:       {ID: BiomeDesert, Top: sand, Filler: sandstone, Trees: 0.004, Grass: 0.005, Cactus: true},
+	BiomeExtremeHills: {ID: BiomeExtremeHills, Top: grass, Filler: dirt, Trees: 0.004, Grass: 0.05, Tree: treeSpruce},
+	BiomeForest:       {ID: BiomeForest, Top: grass, Filler: dirt, Trees: 0.04, Grass: 0.08, Flowers: 0.01, Tree: treeOak},
+	BiomeBirchForest:  {ID: BiomeBirchForest, Top: grass, Filler: dirt, Trees: 0.04, Grass: 0.08, Flowers: 0.01, Tree: treeBirch},
+	BiomeTaiga:        {ID: BiomeTaiga, Top: grass, Filler: dirt, Trees: 0.03, Grass: 0.08, Tree: treeSpruce},
+	BiomeSwampland:    {ID: BiomeSwampland, Top: grass, Filler: dirt, Trees: 0.01, Grass: 0.1, Flowers: 0.005, Tree: treeOak},
+	BiomeBeach:        {ID: BiomeBeach, Top: sand, Filler: sand},
+	BiomeJungle:       {ID: BiomeJungle, Top: grass, Filler: dirt, Trees: 0.06, Grass: 0.25, Flowers: 0.005, Tree: treeJungle},
+}
+
+// selectBiome choose biome by surface height, temperature and humidity in [-1, 1].
+func selectBiome(height, seaLevel int, temperature, humidity float64) *biome {
+	switch {
+	case height < seaLevel-20:
+		return biomes[BiomeDeepOcean]
+	case height < seaLevel-1:
+		return biomes[BiomeOcean]
+	case height <= seaLevel+1 && temperature > -0.3:
+		return biomes[BiomeBeach]
+	case height > seaLevel+28:
+		return biomes[BiomeExtremeHills]
+	}
+
+	switch {
+	case temperature > 0.3 && humidity < 0:
+		return biomes[BiomeDesert]
+	case temperature > 0.3:
+		return biomes[BiomeJungle]
+	case temperature < -0.3:
+		return biomes[BiomeTaiga]
+	case humidity > 0.35 && height <= seaLevel+4:
+		return biomes[BiomeSwampland]
+	case humidity > 0.2:
+		return biomes[BiomeBirchForest]
+	case humidity > -0.1:
+		return biomes[BiomeForest]
+	default:
+		return biomes[BiomePlains]
+	}
+}
@@ -0,0 +1,73 @@
+package generate
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/laushunyu/real/world"
+)
+
+// NameEnd is generator name of end worlds.
+const NameEnd = "end"
+
+// BiomeSky is biome of the end.
+const BiomeSky = 9
+
+var endStone = world.MustParseBlockState("minecraft:end_stone")
+
+// End generate the main island of end stone around the origin, others are void.
+type End struct {
+	// Radius of the island in blocks
+	Radius float64
+	// Y of the island top at the center
+	Top int
+
+	mu     sync.Mutex
+	noises map[int64]*Octaves
+}
+
+var _ world.Generator = (*End)(nil)
+
+func NewEnd() *End {
+	return &End{
+		Radius: 96,
+		Top:    64,
+		noises: make(map[int64]*Octaves),
+	}
+}
+
+func (e *End) noise(seed int64) *Octaves {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, ok := e.noises[seed]
+	if !ok {
+		o = NewOctaves(rand.New(rand.NewSource(seed)), 3)
+		e.noises[seed] = o
+	}
+	return o
+}
+
+func (e *End) Generate(x, z int32, seed int64) *world.Chunk {
+	chunk := world.NewChunk(x, z)
+	fillBiome(chunk, BiomeSky)
+	noise := e.noise(seed)
+
+	for lz := 0; lz < 16; lz++ {
+		for lx := 0; lx < 16; lx++ {
+			wx, wz := float64(int(x)<<4|lx), float64(int(z)<<4|lz)
+			// edge of island is ragged by noise
+			d := math.Hypot(wx, wz) / (e.Radius * (1 + noise.Noise2D(wx/64, wz/64)*0.3))
+			if d >= 1 {
+				continue
+			}
+			// the island is flat on top and thicker in the middle
+			top := e.Top + int(noise.Noise2D(wx/32, wz/32)*4)
+			bottom := top - int((1-d*d)*40) - 2
+			for y := bottom; y <= top; y++ {
+				chunk.SetBlock(lx, y, lz, endStone)
+			}
+		}
+	}
+	return chunk
+}
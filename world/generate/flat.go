@@ -0,0 +1,210 @@
+package generate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/laushunyu/real/world"
+)
+
+// DefaultFlatPreset is the classic flat preset of vanilla.
+const DefaultFlatPreset = "3;minecraft:bedrock,2*minecraft:dirt,minecraft:grass;1;village"
+
+// BiomePlains is biome of flat world if preset not given.
+const BiomePlains = 1
+
+// FlatLayer is Count blocks of State stacked.
+type FlatLayer struct {
+	State world.BlockState
+	Count int
+}
+
+// Flat generate superflat chunks with layers from bottom to top.
+type Flat struct {
+	Layers []FlatLayer
+	Biome  byte
+	// Structures is options of structures like village or biome_1(distance=32),
+	// they are kept in preset but not generated
+	Structures map[string]map[string]string
+}
+
+var _ world.Generator = (*Flat)(nil)
+
+// ParseFlatPreset parse superflat preset of version 3 like
+// 3;minecraft:bedrock,2*minecraft:dirt,minecraft:grass;1;village,
+// version 2 presets with numeric ids like 2;7,2x3,2;1 are also accepted.
+// Empty preset means DefaultFlatPreset.
+func ParseFlatPreset(preset string) (*Flat, error) {
+	preset = strings.TrimSpace(preset)
+	if preset == "" {
+		preset = DefaultFlatPreset
+	}
+
+	parts := strings.Split(preset, ";")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid flat preset %q", preset)
+	}
+	version, err := strconv.Atoi(parts[0])
+	if err != nil || version < 0 || version > 3 {
+		return nil, fmt.Errorf("unsupported version %q of flat preset", parts[0])
+	}
+
+	flat := &Flat{Biome: BiomePlains, Structures: make(map[string]map[string]string)}
+
+	height := 0
+	for _, str := range strings.Split(parts[1], ",") {
+		layer, err := parseFlatLayer(strings.TrimSpace(str), version)
+		if err != nil {
+			return nil, err
+		}
+		height += layer.Count
+		if height > world.SectionCount*16 {
+			return nil, fmt.Errorf("flat layers are higher than %d", world.SectionCount*16)
+		}
+		flat.Layers = append(flat.Layers, layer)
+	}
+
+	if len(parts) > 2 && parts[2] != "" {
+		biome, err := strconv.ParseUint(parts[2], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid biome %q of flat preset", parts[2])
+		}
+		flat.Biome = byte(biome)
+	}
+
+	if len(parts) > 3 && parts[3] != "" {
+		for _, str := range strings.Split(parts[3], ",") {
+			name, options, err := parseFlatStructure(str)
+			if err != nil {
+				return nil, err
+			}
+			flat.Structures[name] = options
+		}
+	}
+	return flat, nil
+}
+
+// parseFlatLayer parse layer like 2*minecraft:dirt, minecraft:stone:1 or 2x3 in version 2.
+func parseFlatLayer(str string, version int) (FlatLayer, error) {
+	layer := FlatLayer{Count: 1}
+
+	sep := "*"
+	if version < 3 {
+		sep = "x"
+	}
+	if i := strings.Index(str, sep); i >= 0 {
+		count, err := strconv.Atoi(str[:i])
+		if err != nil || count <= 0 {
+			return layer, fmt.Errorf("invalid count of flat layer %q", str)
+		}
+		layer.Count, str = count, str[i+1:]
+	}
+
+	// block is name or id, optionally followed by :meta
+	name, meta := str, uint8(0)
+	if i := strings.LastIndexByte(str, ':'); i >= 0 {
+		if m, err := strconv.ParseUint(str[i+1:], 10, 4); err == nil {
+			name, meta = str[:i], uint8(m)
+		}
+	}
+
+	var block *world.Block
+	if id, err := strconv.ParseUint(name, 10, 16); err == nil {
+		block = world.BlockByID(uint16(id))
+	} else {
+		block = world.BlockByName(name)
+	}
+	if block == nil {
+		return layer, fmt.Errorf("unknown block %q of flat layer", name)
+	}
+
+	layer.State = world.NewBlockState(block.ID, meta)
+	if !layer.State.Valid() {
+		layer.State = block.DefaultState()
+	}
+	return layer, nil
+}
+
+// parseFlatStructure parse structure like biome_1(distance=32 count=4).
+func parseFlatStructure(str string) (string, map[string]string, error) {
+	options := make(map[string]string)
+	i := strings.IndexByte(str, '(')
+	if i < 0 {
+		return str, options, nil
+	}
+	if !strings.HasSuffix(str, ")") {
+		return "", nil, fmt.Errorf("invalid structure %q of flat preset", str)
+	}
+	for _, option := range strings.Fields(str[i+1 : len(str)-1]) {
+		kv := strings.SplitN(option, "=", 2)
+		if len(kv) != 2 {
+			return "", nil, fmt.Errorf("invalid option %q of structure %s", option, str[:i])
+		}
+		options[kv[0]] = kv[1]
+	}
+	return str[:i], options, nil
+}
+
+// String return preset of version 3.
+func (f *Flat) String() string {
+	layers := make([]string, len(f.Layers))
+	for i, layer := range f.Layers {
+		str := layer.State.Block().Name
+		if layer.State.Meta() != 0 {
+			str += ":" + strconv.Itoa(int(layer.State.Meta()))
+		}
+		if layer.Count > 1 {
+			str = strconv.Itoa(layer.Count) + "*" + str
+		}
+		layers[i] = str
+	}
+
+	names := make([]string, 0, len(f.Structures))
+	for name := range f.Structures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	structures := make([]string, len(names))
+	for i, name := range names {
+		var options []string
+		for k, v := range f.Structures[name] {
+			options = append(options, k+"="+v)
+		}
+		sort.Strings(options)
+		structures[i] = name
+		if len(options) > 0 {
+			structures[i] += "(" + strings.Join(options, " ") + ")"
+		}
+	}
+
+	return fmt.Sprintf("3;%s;%d;%s", strings.Join(layers, ","), f.Biome, strings.Join(structures, ","))
+}
+
+func (f *Flat) Generate(x, z int32, seed int64) *world.Chunk {
+	chunk := world.NewChunk(x, z)
+	y := 0
+	for _, layer := range f.Layers {
+		for i := 0; i < layer.Count; i, y = i+1, y+1 {
+			if layer.State == world.Air {
+				continue
+			}
+			for bz := 0; bz < 16; bz++ {
+				for bx := 0; bx < 16; bx++ {
+					chunk.SetBlock(bx, y, bz, layer.State)
+				}
+			}
+		}
+	}
+	fillBiome(chunk, f.Biome)
+	return chunk
+}
+
+func fillBiome(chunk *world.Chunk, biome byte) {
+	var biomes [256]byte
+	for i := range biomes {
+		biomes[i] = biome
+	}
+	chunk.SetBiomes(biomes)
+}
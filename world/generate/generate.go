@@ -0,0 +1,45 @@
+package generate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/laushunyu/real/world"
+)
+
+// generator names saved as generatorName in level.dat
+const (
+	NameFlat = "flat"
+	NameVoid = "void"
+)
+
+// BiomeVoid is biome of void world.
+const BiomeVoid = 127
+
+// Void generate empty chunks.
+type Void struct{}
+
+func (Void) Generate(x, z int32, seed int64) *world.Chunk {
+	chunk := world.NewChunk(x, z)
+	fillBiome(chunk, BiomeVoid)
+	return chunk
+}
+
+// New return generator by name and options of level.
+func New(name, options string) (world.Generator, error) {
+	switch strings.ToLower(name) {
+	case NameFlat:
+		return ParseFlatPreset(options)
+	case NameVoid:
+		return Void{}, nil
+	case NameNether:
+		return NewNether(), nil
+	case NameEnd:
+		return NewEnd(), nil
+	case NameDefault, "default_1_1", "largebiomes", "amplified", "customized":
+		// variants of vanilla terrain share the same generator
+		return NewTerrain(), nil
+	default:
+		return nil, fmt.Errorf("unknown generator %q", name)
+	}
+}
@@ -0,0 +1,98 @@
+package generate
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/laushunyu/real/world"
+)
+
+// NameNether is generator name of nether worlds.
+const NameNether = "nether"
+
+// BiomeHell is biome of the nether.
+const BiomeHell = 8
+
+var (
+	netherrack = world.MustParseBlockState("minecraft:netherrack")
+	glowstone  = world.MustParseBlockState("minecraft:glowstone")
+)
+
+// Nether generate netherrack between bedrock floor and roof,
+// with caverns carved by noise and lava seas at the bottom.
+type Nether struct {
+	Height, LavaLevel int
+
+	mu     sync.Mutex
+	noises map[int64]*Octaves
+}
+
+var _ world.Generator = (*Nether)(nil)
+
+func NewNether() *Nether {
+	return &Nether{
+		Height:    128,
+		LavaLevel: 32,
+		noises:    make(map[int64]*Octaves),
+	}
+}
+
+func (n *Nether) noise(seed int64) *Octaves {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	o, ok := n.noises[seed]
+	if !ok {
+		o = NewOctaves(rand.New(rand.NewSource(seed)), 3)
+		n.noises[seed] = o
+	}
+	return o
+}
+
+func (n *Nether) Generate(x, z int32, seed int64) *world.Chunk {
+	chunk := world.NewChunk(x, z)
+	fillBiome(chunk, BiomeHell)
+	noise := n.noise(seed)
+	r := chunkRand(seed, x, z)
+
+	for lz := 0; lz < 16; lz++ {
+		for lx := 0; lx < 16; lx++ {
+			wx, wz := float64(int(x)<<4|lx), float64(int(z)<<4|lz)
+			for y := 0; y < n.Height; y++ {
+				var state world.BlockState
+				switch {
+				case y == 0 || y == n.Height-1 || y < 4 && r.Intn(y+1) == 0 || y > n.Height-5 && r.Intn(n.Height-y) == 0:
+					state = bedrock
+				case cavern(noise, wx, float64(y), wz, n.Height):
+					if y <= n.LavaLevel {
+						state = lava
+					}
+				default:
+					state = netherrack
+				}
+				if state != 0 {
+					chunk.SetBlock(lx, y, lz, state)
+				}
+			}
+		}
+	}
+
+	// glowstone hangs under the roof of caverns
+	for i := r.Intn(3); i > 0; i-- {
+		lx, lz := r.Intn(16), r.Intn(16)
+		for y := n.Height - 6; y > n.LavaLevel; y-- {
+			if chunk.Block(lx, y, lz) == 0 && chunk.Block(lx, y+1, lz) == netherrack {
+				chunk.SetBlock(lx, y, lz, glowstone)
+				break
+			}
+		}
+	}
+	return chunk
+}
+
+// cavern report whether x, y, z is air, caverns are wide in the middle and closed near floor and roof.
+func cavern(o *Octaves, x, y, z float64, height int) bool {
+	mid := float64(height) / 2
+	// -1 at floor and roof, 0 in the middle
+	edge := (y - mid) / mid
+	return o.Noise3D(x/48, y/24, z/48)+0.45-edge*edge*0.9 > 0.1
+}
@@ -0,0 +1,118 @@
+package generate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Perlin is improved perlin noise with permutation shuffled by seed.
+type Perlin struct {
+	perm [512]uint8
+	// offset so that noise at integer coordinates is not always 0
+	ox, oy, oz float64
+}
+
+func NewPerlin(r *rand.Rand) *Perlin {
+	p := &Perlin{
+		ox: r.Float64() * 256,
+		oy: r.Float64() * 256,
+		oz: r.Float64() * 256,
+	}
+	for i := 0; i < 256; i++ {
+		p.perm[i] = uint8(i)
+	}
+	for i := 255; i > 0; i-- {
+		j := r.Intn(i + 1)
+		p.perm[i], p.perm[j] = p.perm[j], p.perm[i]
+	}
+	copy(p.perm[256:], p.perm[:256])
+	return p
+}
+
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+func grad(hash uint8, x, y, z float64) float64 {
+	// 12 gradient directions to edges of a cube
+	h := hash & 15
+	u, v := x, y
+	if h >= 8 {
+		u = y
+	}
+	if h >= 4 {
+		if h == 12 || h == 14 {
+			v = x
+		} else {
+			v = z
+		}
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
+
+// Noise3D return noise in about [-1, 1] at x, y, z.
+func (p *Perlin) Noise3D(x, y, z float64) float64 {
+	x, y, z = x+p.ox, y+p.oy, z+p.oz
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	xi, yi, zi := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z = x-fx, y-fy, z-fz
+	u, v, w := fade(x), fade(y), fade(z)
+
+	perm := &p.perm
+	a := int(perm[xi]) + yi
+	aa, ab := int(perm[a])+zi, int(perm[a+1])+zi
+	b := int(perm[xi+1]) + yi
+	ba, bb := int(perm[b])+zi, int(perm[b+1])+zi
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad(perm[aa], x, y, z), grad(perm[ba], x-1, y, z)),
+			lerp(u, grad(perm[ab], x, y-1, z), grad(perm[bb], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, grad(perm[aa+1], x, y, z-1), grad(perm[ba+1], x-1, y, z-1)),
+			lerp(u, grad(perm[ab+1], x, y-1, z-1), grad(perm[bb+1], x-1, y-1, z-1))))
+}
+
+// Noise2D return noise in about [-1, 1] at x, z.
+func (p *Perlin) Noise2D(x, z float64) float64 {
+	return p.Noise3D(x, 0, z)
+}
+
+// Octaves sum perlin noises, each octave has double frequency and half amplitude of the previous.
+type Octaves struct {
+	noises []*Perlin
+}
+
+func NewOctaves(r *rand.Rand, n int) *Octaves {
+	o := &Octaves{noises: make([]*Perlin, n)}
+	for i := range o.noises {
+		o.noises[i] = NewPerlin(r)
+	}
+	return o
+}
+
+// Noise3D return sum of octaves normalized to about [-1, 1].
+func (o *Octaves) Noise3D(x, y, z float64) float64 {
+	var sum, max float64
+	freq, amp := 1.0, 1.0
+	for _, p := range o.noises {
+		sum += p.Noise3D(x*freq, y*freq, z*freq) * amp
+		max += amp
+		freq, amp = freq*2, amp/2
+	}
+	return sum / max
+}
+
+func (o *Octaves) Noise2D(x, z float64) float64 {
+	return o.Noise3D(x, 0, z)
+}
@@ -0,0 +1,197 @@
+package generate
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/laushunyu/real/world"
+)
+
+// NameDefault is generator name of vanilla terrain in level.dat.
+const NameDefault = "default"
+
+// terrainNoise is noises of a seed.
+type terrainNoise struct {
+	continent, hills, detail *Octaves
+	temperature, humidity    *Octaves
+	caveA, caveB             *Octaves
+}
+
+func newTerrainNoise(seed int64) *terrainNoise {
+	r := rand.New(rand.NewSource(seed))
+	return &terrainNoise{
+		continent:   NewOctaves(r, 4),
+		hills:       NewOctaves(r, 2),
+		detail:      NewOctaves(r, 4),
+		temperature: NewOctaves(r, 2),
+		humidity:    NewOctaves(r, 2),
+		caveA:       NewOctaves(r, 2),
+		caveB:       NewOctaves(r, 2),
+	}
+}
+
+// Terrain generate hills, oceans and caves from noise,
+// the same seed and chunk position always give the same chunk.
+type Terrain struct {
+	SeaLevel int
+
+	mu     sync.Mutex
+	noises map[int64]*terrainNoise
+}
+
+var _ world.Generator = (*Terrain)(nil)
+
+func NewTerrain() *Terrain {
+	return &Terrain{
+		SeaLevel: 63,
+		noises:   make(map[int64]*terrainNoise),
+	}
+}
+
+func (t *Terrain) noise(seed int64) *terrainNoise {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n, ok := t.noises[seed]
+	if !ok {
+		n = newTerrainNoise(seed)
+		t.noises[seed] = n
+	}
+	return n
+}
+
+// chunkRand return random of chunk x, z for things not from noise.
+func chunkRand(seed int64, x, z int32) *rand.Rand {
+	return rand.New(rand.NewSource(seed ^ int64(x)*341873128712 ^ int64(z)*132897987541))
+}
+
+func clamp(v, min, max float64) float64 {
+	return math.Max(min, math.Min(max, v))
+}
+
+// Height return surface height and biome of column at world x, z.
+func (t *Terrain) Height(x, z int, seed int64) (int, byte) {
+	height, b := t.column(t.noise(seed), float64(x), float64(z))
+	return height, b.ID
+}
+
+func (t *Terrain) column(n *terrainNoise, x, z float64) (int, *biome) {
+	// land where continent > 0, ocean where < 0
+	continent := clamp(n.continent.Noise2D(x/640, z/640)*2.5, -1, 1)
+	hills := clamp(n.hills.Noise2D(x/320, z/320)*2+0.5, 0, 1)
+	detail := n.detail.Noise2D(x/96, z/96)
+
+	h := float64(t.SeaLevel) + 2 + continent*24 + detail*(4+hills*hills*48)
+	height := int(clamp(h, 8, float64(world.SectionCount*16-32)))
+
+	temperature := clamp(n.temperature.Noise2D(x/512, z/512)*2.5, -1, 1)
+	humidity := clamp(n.humidity.Noise2D(x/512, z/512)*2.5, -1, 1)
+	return height, selectBiome(height, t.SeaLevel, temperature, humidity)
+}
+
+// isCave report whether block at world x, y, z is carved by two noises crossing zero.
+func isCave(n *terrainNoise, x, y, z float64) bool {
+	a := n.caveA.Noise3D(x/48, y/24, z/48)
+	b := n.caveB.Noise3D(x/48, y/24, z/48)
+	return a*a+b*b < 0.003
+}
+
+func (t *Terrain) Generate(x, z int32, seed int64) *world.Chunk {
+	n := t.noise(seed)
+	r := chunkRand(seed, x, z)
+	chunk := world.NewChunk(x, z)
+
+	var heights [16][16]int
+	var columns [16][16]*biome
+	var biomeIDs [256]byte
+
+	for lz := 0; lz < 16; lz++ {
+		for lx := 0; lx < 16; lx++ {
+			wx, wz := float64(int(x)<<4|lx), float64(int(z)<<4|lz)
+			height, b := t.column(n, wx, wz)
+			heights[lx][lz], columns[lx][lz] = height, b
+			biomeIDs[lz<<4|lx] = b.ID
+
+			top, filler := b.Top, b.Filler
+			if height < t.SeaLevel {
+				// sea floor
+				top, filler = sand, sand
+				if height < t.SeaLevel-4 {
+					top, filler = gravel, gravel
+				}
+			}
+			depth := 3 + r.Intn(2)
+
+			for y := 0; y <= height; y++ {
+				var state world.BlockState
+				switch {
+				case y == 0 || y < 5 && r.Intn(5) >= y:
+					state = bedrock
+				case y == height:
+					state = top
+				case y > height-depth:
+					state = filler
+				default:
+					state = stone
+				}
+
+				// caves do not break sea floor so water stays above
+				if state != bedrock && (height >= t.SeaLevel || y < height-4) && isCave(n, wx, float64(y), wz) {
+					if y <= 10 {
+						chunk.SetBlock(lx, y, lz, lava)
+					}
+					continue
+				}
+				chunk.SetBlock(lx, y, lz, state)
+			}
+			for y := height + 1; y <= t.SeaLevel; y++ {
+				chunk.SetBlock(lx, y, lz, water)
+			}
+		}
+	}
+	chunk.SetBiomes(biomeIDs)
+
+	t.decorate(chunk, &heights, &columns, r)
+	return chunk
+}
+
+// decorate grow trees and plants on surface.
+func (t *Terrain) decorate(chunk *world.Chunk, heights *[16][16]int, columns *[16][16]*biome, r *rand.Rand) {
+	for lz := 0; lz < 16; lz++ {
+		for lx := 0; lx < 16; lx++ {
+			height, b := heights[lx][lz], columns[lx][lz]
+			// roll every column even if skipped so results do not depend on others
+			roll := r.Float64()
+			if height < t.SeaLevel || chunk.Block(lx, height, lz) != b.Top || chunk.Block(lx, height+1, lz) != world.Air {
+				continue
+			}
+
+			inner := lx >= treeRadius && lx < 16-treeRadius && lz >= treeRadius && lz < 16-treeRadius
+			switch {
+			case roll < b.Trees:
+				if b.Cactus {
+					if lx > 0 && lx < 15 && lz > 0 && lz < 15 {
+						placeCactus(chunk, lx, height+1, lz, r)
+					}
+				} else if inner {
+					placeTree(chunk, lx, height+1, lz, b.Tree, r)
+				}
+			case roll < b.Trees+b.Grass:
+				plant := tallGrass
+				switch {
+				case b.Cactus:
+					plant = deadBush
+				case b.Tree == treeSpruce && r.Intn(3) == 0:
+					plant = fern
+				}
+				chunk.SetBlock(lx, height+1, lz, plant)
+			case roll < b.Trees+b.Grass+b.Flowers:
+				plant := dandelion
+				if r.Intn(2) == 0 {
+					plant = poppy
+				}
+				chunk.SetBlock(lx, height+1, lz, plant)
+			}
+		}
+	}
+}
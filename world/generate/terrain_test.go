@@ -0,0 +1,40 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/laushunyu/real/world"
+)
+
+// sameChunk report whether a and b have the same blocks and biomes.
+func sameChunk(a, b *world.Chunk) bool {
+	if a.Biomes() != b.Biomes() {
+		return false
+	}
+	for y := 0; y < world.SectionCount*16; y++ {
+		for z := 0; z < 16; z++ {
+			for x := 0; x < 16; x++ {
+				if a.Block(x, y, z) != b.Block(x, y, z) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func TestTerrainDeterministic(t *testing.T) {
+	const seed = 20180718
+	// a generator reused for many chunks and seeds gives the same as a new one
+	gen := NewTerrain()
+	for _, pos := range [][2]int32{{0, 0}, {-7, 12}, {300, -45}} {
+		a := gen.Generate(pos[0], pos[1], seed)
+		b := NewTerrain().Generate(pos[0], pos[1], seed)
+		if !sameChunk(a, b) {
+			t.Errorf("chunk %v of seed %d differs between generations", pos, seed)
+		}
+		if c := gen.Generate(pos[0], pos[1], seed+1); sameChunk(a, c) {
+			t.Errorf("chunk %v is the same with seeds %d and %d", pos, seed, seed+1)
+		}
+	}
+}
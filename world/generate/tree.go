@@ -0,0 +1,130 @@
+package generate
+
+import (
+	"math/rand"
+
+	"github.com/laushunyu/real/world"
+)
+
+type treeKind uint8
+
+const (
+	treeOak treeKind = iota
+	treeBirch
+	treeSpruce
+	treeJungle
+)
+
+var (
+	tallGrass = world.MustParseBlockState("minecraft:tallgrass[type=tall_grass]")
+	fern      = world.MustParseBlockState("minecraft:tallgrass[type=fern]")
+	deadBush  = world.MustParseBlockState("minecraft:deadbush")
+	dandelion = world.MustParseBlockState("minecraft:yellow_flower[type=dandelion]")
+	poppy     = world.MustParseBlockState("minecraft:red_flower[type=poppy]")
+	cactus    = world.MustParseBlockState("minecraft:cactus[age=0]")
+
+	treeBlocks = map[treeKind][2]world.BlockState{
+		treeOak:    {world.MustParseBlockState("minecraft:log[axis=y,variant=oak]"), world.MustParseBlockState("minecraft:leaves[check_decay=false,decayable=true,variant=oak]")},
+		treeBirch:  {world.MustParseBlockState("minecraft:log[axis=y,variant=birch]"), world.MustParseBlockState("minecraft:leaves[check_decay=false,decayable=true,variant=birch]")},
+		treeSpruce: {world.MustParseBlockState("minecraft:log[axis=y,variant=spruce]"), world.MustParseBlockState("minecraft:leaves[check_decay=false,decayable=true,variant=spruce]")},
+		treeJungle: {world.MustParseBlockState("minecraft:log[axis=y,variant=jungle]"), world.MustParseBlockState("minecraft:leaves[check_decay=false,decayable=true,variant=jungle]")},
+	}
+)
+
+// treeRadius is how far leaves reach from trunk, trees are only placed
+// where leaves stay inside the chunk so chunks can be generated alone.
+const treeRadius = 2
+
+// placeTree grow a tree of kind with trunk from x, y, z in chunk.
+func placeTree(chunk *world.Chunk, x, y, z int, kind treeKind, r *rand.Rand) {
+	log, leaves := treeBlocks[kind][0], treeBlocks[kind][1]
+
+	var height int
+	switch kind {
+	case treeOak:
+		height = 4 + r.Intn(3)
+	case treeBirch:
+		height = 5 + r.Intn(3)
+	case treeSpruce:
+		height = 6 + r.Intn(4)
+	case treeJungle:
+		height = 7 + r.Intn(5)
+	}
+	top := y + height
+	if top+1 >= world.SectionCount*16 {
+		return
+	}
+
+	setLeaves := func(lx, ly, lz int) {
+		if chunk.Block(lx, ly, lz) == world.Air {
+			chunk.SetBlock(lx, ly, lz, leaves)
+		}
+	}
+
+	switch kind {
+	case treeSpruce:
+		// cone from wide bottom to the tip
+		for ly := y + 2; ly <= top; ly++ {
+			// alternate between wide and narrow rings
+			radius := 1 + (top-ly)%treeRadius
+			if ly == top {
+				radius = 0
+			}
+			for dz := -radius; dz <= radius; dz++ {
+				for dx := -radius; dx <= radius; dx++ {
+					if radius > 0 && abs(dx) == radius && abs(dz) == radius {
+						continue
+					}
+					setLeaves(x+dx, ly, z+dz)
+				}
+			}
+		}
+		setLeaves(x, top+1, z)
+	default:
+		// two wide layers and two narrow layers at the top
+		for ly := top - 3; ly <= top; ly++ {
+			radius := treeRadius
+			if ly >= top-1 {
+				radius = 1
+			}
+			for dz := -radius; dz <= radius; dz++ {
+				for dx := -radius; dx <= radius; dx++ {
+					// randomly cut corners
+					if abs(dx) == radius && abs(dz) == radius && (ly == top || r.Intn(2) == 0) {
+						continue
+					}
+					setLeaves(x+dx, ly, z+dz)
+				}
+			}
+		}
+	}
+
+	for ly := y; ly < top; ly++ {
+		chunk.SetBlock(x, ly, z, log)
+	}
+	// the block under trunk becomes dirt
+	chunk.SetBlock(x, y-1, z, dirt)
+}
+
+// placeCactus grow cactus of 1 to 3 blocks if nothing beside it,
+// x and z must not be on the edge of chunk.
+func placeCactus(chunk *world.Chunk, x, y, z int, r *rand.Rand) {
+	height := 1 + r.Intn(3)
+	for ly := y; ly < y+height; ly++ {
+		for _, d := range [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			if chunk.Block(x+d[0], ly, z+d[1]) != world.Air {
+				return
+			}
+		}
+	}
+	for ly := y; ly < y+height; ly++ {
+		chunk.SetBlock(x, ly, z, cactus)
+	}
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,124 @@
+package world
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// names of game rules used by server
+const (
+	GameRuleDoDaylightCycle = "doDaylightCycle"
+	GameRuleDoWeatherCycle  = "doWeatherCycle"
+	GameRuleKeepInventory   = "keepInventory"
+	GameRuleNaturalRegen    = "naturalRegeneration"
+	GameRuleShowDeathMsg    = "showDeathMessages"
+)
+
+// DefaultGameRules is game rules of a new level in 1.12.
+var DefaultGameRules = map[string]string{
+	"announceAdvancements":       "true",
+	"commandBlockOutput":         "true",
+	"disableElytraMovementCheck": "false",
+	GameRuleDoDaylightCycle:      "true",
+	"doEntityDrops":              "true",
+	"doFireTick":                 "true",
+	"doLimitedCrafting":          "false",
+	"doMobLoot":                  "true",
+	"doMobSpawning":              "true",
+	"doTileDrops":                "true",
+	GameRuleDoWeatherCycle:       "true",
+	"gameLoopFunction":           "-",
+	GameRuleKeepInventory:        "false",
+	"logAdminCommands":           "true",
+	"maxCommandChainLength":      "65536",
+	"maxEntityCramming":          "24",
+	"mobGriefing":                "true",
+	GameRuleNaturalRegen:         "true",
+	"randomTickSpeed":            "3",
+	"reducedDebugInfo":           "false",
+	"sendCommandFeedback":        "true",
+	GameRuleShowDeathMsg:         "true",
+	"spawnRadius":                "10",
+	"spectatorsGenerateChunks":   "true",
+}
+
+// Level is the world info saved in level.dat.
+type Level struct {
+	Name string
+	Seed int64
+
+	SpawnX, SpawnY, SpawnZ int32
+
+	// Time is ticks the world has run, DayTime is time of day which can be changed by commands
+	Time, DayTime int64
+
+	// weather and ticks until it changes
+	Raining          bool
+	RainTime         int32
+	Thundering       bool
+	ThunderTime      int32
+	ClearWeatherTime int32
+
+	GameType   int32
+	Difficulty byte
+	Hardcore   bool
+
+	// GeneratorName is level type like flat, default or void,
+	// GeneratorOptions is the preset of it
+	GeneratorName    string
+	GeneratorOptions string
+
+	GameRules map[string]string
+
+	// LastPlayed is unix milliseconds when level saved
+	LastPlayed int64
+}
+
+// NewLevel return level with a random seed and default game rules.
+func NewLevel(name string) *Level {
+	l := &Level{
+		Name:          name,
+		Seed:          rand.New(rand.NewSource(time.Now().UnixNano())).Int63(),
+		SpawnY:        64,
+		GameType:      1,
+		Difficulty:    1,
+		GeneratorName: "flat",
+		GameRules:     make(map[string]string, len(DefaultGameRules)),
+	}
+	for rule, value := range DefaultGameRules {
+		l.GameRules[rule] = value
+	}
+	return l
+}
+
+// Clone return a deep copy of l.
+func (l *Level) Clone() *Level {
+	c := *l
+	c.GameRules = make(map[string]string, len(l.GameRules))
+	for rule, value := range l.GameRules {
+		c.GameRules[rule] = value
+	}
+	return &c
+}
+
+// GameRule return value of game rule, the default value if not set.
+func (l *Level) GameRule(rule string) string {
+	if value, ok := l.GameRules[rule]; ok {
+		return value
+	}
+	return DefaultGameRules[rule]
+}
+
+// BoolGameRule return game rule as bool, false if it is not a bool.
+func (l *Level) BoolGameRule(rule string) bool {
+	v, _ := strconv.ParseBool(l.GameRule(rule))
+	return v
+}
+
+func (l *Level) SetGameRule(rule, value string) {
+	if l.GameRules == nil {
+		l.GameRules = make(map[string]string)
+	}
+	l.GameRules[rule] = value
+}
@@ -0,0 +1,321 @@
+package world
+
+import (
+	"sync/atomic"
+)
+
+const maxLight = 15
+
+type lightNode struct {
+	x, y, z int
+	level   uint8
+}
+
+// the 6 faces of a block, down first
+var lightFaces = [6][3]int{{0, -1, 0}, {0, 1, 0}, {-1, 0, 0}, {1, 0, 0}, {0, 0, -1}, {0, 0, 1}}
+
+// lightEngine flood fills one kind of light in world coordinates,
+// it only reaches chunks which are loaded and lit.
+type lightEngine struct {
+	w    *World
+	kind LightKind
+	// the chunk being lit, it is reachable before marked lit
+	target *Chunk
+	// last chunk accessed, most accesses are in the same chunk
+	last *Chunk
+}
+
+func (e *lightEngine) chunk(x, z int) *Chunk {
+	cx, cz := int32(x>>4), int32(z>>4)
+	if e.last != nil && e.last.X == cx && e.last.Z == cz {
+		return e.last
+	}
+	c := e.target
+	if c == nil || c.X != cx || c.Z != cz {
+		c = e.w.loadedChunk(cx, cz)
+		if c == nil || atomic.LoadInt32(&c.lit) == 0 {
+			return nil
+		}
+	}
+	e.last = c
+	return c
+}
+
+// get return light at x, y, z, false if out of world or chunk not reachable.
+func (e *lightEngine) get(x, y, z int) (uint8, bool) {
+	if y < 0 || y >= SectionCount*16 {
+		return 0, false
+	}
+	c := e.chunk(x, z)
+	if c == nil {
+		return 0, false
+	}
+	return c.Light(e.kind, x&0xF, y, z&0xF), true
+}
+
+func (e *lightEngine) set(x, y, z int, level uint8) {
+	if c := e.chunk(x, z); c != nil {
+		c.SetLight(e.kind, x&0xF, y, z&0xF, level)
+	}
+}
+
+// opacity return how much light is reduced into block at x, y, z.
+func (e *lightEngine) opacity(x, y, z int) uint8 {
+	c := e.chunk(x, z)
+	if c == nil {
+		return maxLight
+	}
+	return blockOpacity(c.Block(x&0xF, y, z&0xF))
+}
+
+func blockOpacity(state BlockState) uint8 {
+	b := state.Block()
+	if b == nil {
+		return 0
+	}
+	if b.Opacity > maxLight {
+		return maxLight
+	}
+	return b.Opacity
+}
+
+func blockEmission(state BlockState) uint8 {
+	if b := state.Block(); b != nil {
+		return b.Light
+	}
+	return 0
+}
+
+// increase spread light from nodes to their neighbors until it fades out.
+func (e *lightEngine) increase(queue []lightNode) {
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		level, ok := e.get(n.x, n.y, n.z)
+		if !ok || level <= 1 {
+			continue
+		}
+		for i, face := range lightFaces {
+			x, y, z := n.x+face[0], n.y+face[1], n.z+face[2]
+			cur, ok := e.get(x, y, z)
+			if !ok {
+				continue
+			}
+
+			opacity := e.opacity(x, y, z)
+			var next uint8
+			switch {
+			case e.kind == LightSky && i == 0 && level == maxLight && opacity == 0:
+				// sky light goes down without fading
+				next = maxLight
+			case opacity+1 >= level:
+				continue
+			case opacity == 0:
+				next = level - 1
+			default:
+				next = level - opacity
+			}
+			if next > cur {
+				e.set(x, y, z, next)
+				queue = append(queue, lightNode{x: x, y: y, z: z})
+			}
+		}
+	}
+}
+
+// decrease remove light spread from nodes which have been set to 0,
+// it return nodes of other sources which should spread light again.
+func (e *lightEngine) decrease(queue []lightNode) []lightNode {
+	var relight []lightNode
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		for _, face := range lightFaces {
+			x, y, z := n.x+face[0], n.y+face[1], n.z+face[2]
+			cur, ok := e.get(x, y, z)
+			if !ok || cur == 0 {
+				continue
+			}
+			if cur < n.level {
+				// lit by removed light
+				e.set(x, y, z, 0)
+				queue = append(queue, lightNode{x: x, y: y, z: z, level: cur})
+			} else {
+				relight = append(relight, lightNode{x: x, y: y, z: z})
+			}
+		}
+	}
+	return relight
+}
+
+// skyColumn return sky light going straight down at chunk local x, z from y top.
+func skyColumn(c *Chunk, x, z int, top int) []uint8 {
+	levels := make([]uint8, top+1)
+	level := uint8(maxLight)
+	for y := SectionCount*16 - 1; y >= 0; y-- {
+		opacity := blockOpacity(c.Block(x, y, z))
+		if opacity >= level {
+			level = 0
+		} else {
+			level -= opacity
+		}
+		if y <= top {
+			levels[y] = level
+		}
+	}
+	return levels
+}
+
+// topY return y above the highest non-empty section of c.
+func (c *Chunk) topY() int {
+	top := 0
+	c.EachSection(func(i int, s *Section) {
+		top = (i + 1) * 16
+	})
+	return top
+}
+
+// lightChunk compute light of a newly generated or loaded chunk,
+// light is also exchanged with lit neighbors.
+func (w *World) lightChunk(c *Chunk) {
+	w.lightMu.Lock()
+	defer w.lightMu.Unlock()
+	if atomic.LoadInt32(&c.lit) != 0 {
+		return
+	}
+
+	// light flows between c and neighbors below the highest of them
+	top := c.topY()
+	neighbors := make([]*Chunk, 0, 4)
+	for _, d := range [4][2]int32{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		n := w.loadedChunk(c.X+d[0], c.Z+d[1])
+		if n != nil && atomic.LoadInt32(&n.lit) != 0 {
+			neighbors = append(neighbors, n)
+			if t := n.topY(); t > top {
+				top = t
+			}
+		}
+	}
+
+	baseX, baseZ := int(c.X)<<4, int(c.Z)<<4
+	sky := &lightEngine{w: w, kind: LightSky, target: c}
+	block := &lightEngine{w: w, kind: LightBlock, target: c}
+	var skyQueue, blockQueue []lightNode
+	hasSky := w.HasSkyLight()
+
+	for z := 0; hasSky && z < 16; z++ {
+		for x := 0; x < 16; x++ {
+			// sections above are missing and fully lit
+			levels := skyColumn(c, x, z, top)
+			for y := 0; y < top; y++ {
+				c.SetLight(LightSky, x, y, z, levels[y])
+				if levels[y] > 1 {
+					skyQueue = append(skyQueue, lightNode{x: baseX + x, y: y, z: baseZ + z})
+				}
+			}
+		}
+	}
+
+	c.EachSection(func(i int, s *Section) {
+		for j := 0; j < sectionVolume; j++ {
+			x, y, z := j&0xF, j>>8, (j>>4)&0xF
+			s.SetBlockLight(x, y, z, 0)
+			if emission := blockEmission(s.block(j)); emission > 0 {
+				s.SetBlockLight(x, y, z, emission)
+				blockQueue = append(blockQueue, lightNode{x: baseX + x, y: i*16 + y, z: baseZ + z})
+			}
+		}
+	})
+
+	// light of neighbors on the border flows into c
+	for _, n := range neighbors {
+		for i := 0; i < 16; i++ {
+			// the column of n next to c
+			x, z := i, i
+			switch {
+			case n.X < c.X:
+				x = 15
+			case n.X > c.X:
+				x = 0
+			case n.Z < c.Z:
+				z = 15
+			default:
+				z = 0
+			}
+			for y := 0; y < top; y++ {
+				node := lightNode{x: int(n.X)<<4 | x, y: y, z: int(n.Z)<<4 | z}
+				if hasSky && n.Light(LightSky, x, y, z) > 1 {
+					skyQueue = append(skyQueue, node)
+				}
+				if n.Light(LightBlock, x, y, z) > 1 {
+					blockQueue = append(blockQueue, node)
+				}
+			}
+		}
+	}
+
+	atomic.StoreInt32(&c.lit, 1)
+	sky.increase(skyQueue)
+	block.increase(blockQueue)
+	// light of a new chunk is sent with it, only light flowing into neighbors is a modification
+	c.TakeModified()
+}
+
+// updateLight recompute light around world x, y, z after block there changed.
+func (w *World) updateLight(x, y, z int) {
+	w.lightMu.Lock()
+	defer w.lightMu.Unlock()
+
+	c := w.loadedChunk(int32(x>>4), int32(z>>4))
+	if c == nil || atomic.LoadInt32(&c.lit) == 0 {
+		return
+	}
+	state := c.Block(x&0xF, y, z&0xF)
+
+	// block light: remove light at x, y, z, then light it again from emission and neighbors
+	block := &lightEngine{w: w, kind: LightBlock}
+	old, _ := block.get(x, y, z)
+	block.set(x, y, z, 0)
+	relight := block.decrease([]lightNode{{x: x, y: y, z: z, level: old}})
+	if emission := blockEmission(state); emission > 0 {
+		block.set(x, y, z, emission)
+		relight = append(relight, lightNode{x: x, y: y, z: z})
+	}
+	block.increase(append(relight, neighborNodes(x, y, z)...))
+
+	if !w.HasSkyLight() {
+		return
+	}
+	// sky light: the column under the higher of the block and height may change
+	sky := &lightEngine{w: w, kind: LightSky}
+	top := c.Height(x&0xF, z&0xF)
+	if y > top {
+		top = y
+	}
+	var removed []lightNode
+	for cy := 0; cy <= top; cy++ {
+		if level, _ := sky.get(x, cy, z); level > 0 {
+			sky.set(x, cy, z, 0)
+			removed = append(removed, lightNode{x: x, y: cy, z: z, level: level})
+		}
+	}
+	relight = sky.decrease(removed)
+	levels := skyColumn(c, x&0xF, z&0xF, top)
+	for cy := 0; cy <= top; cy++ {
+		if levels[cy] > 0 {
+			sky.set(x, cy, z, levels[cy])
+			relight = append(relight, lightNode{x: x, y: cy, z: z})
+		}
+	}
+	sky.increase(append(relight, neighborNodes(x, y, z)...))
+}
+
+func neighborNodes(x, y, z int) []lightNode {
+	nodes := make([]lightNode, 0, len(lightFaces))
+	for _, face := range lightFaces {
+		nodes = append(nodes, lightNode{x: x + face[0], y: y + face[1], z: z + face[2]})
+	}
+	return nodes
+}
@@ -0,0 +1,80 @@
+package world
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Manager holds worlds by name, the first added one is the default world.
+type Manager struct {
+	mu     sync.RWMutex
+	worlds map[string]*World
+	// names in order added
+	names []string
+}
+
+func NewManager() *Manager {
+	return &Manager{worlds: make(map[string]*World)}
+}
+
+// Add w to m, its name must not be used by others.
+func (m *Manager) Add(w *World) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.worlds[w.Name]; ok {
+		return fmt.Errorf("world %s already exists", w.Name)
+	}
+	m.worlds[w.Name] = w
+	m.names = append(m.names, w.Name)
+	return nil
+}
+
+// Get return world of name, nil if not found.
+func (m *Manager) Get(name string) *World {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.worlds[name]
+}
+
+// Default return the first added world, nil if m is empty.
+func (m *Manager) Default() *World {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if len(m.names) == 0 {
+		return nil
+	}
+	return m.worlds[m.names[0]]
+}
+
+// All return worlds in order added.
+func (m *Manager) All() []*World {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	worlds := make([]*World, len(m.names))
+	for i, name := range m.names {
+		worlds[i] = m.worlds[name]
+	}
+	return worlds
+}
+
+// Save save all worlds, it goes on after a world failed and returns the first error.
+func (m *Manager) Save() error {
+	var first error
+	for _, w := range m.All() {
+		if err := w.Save(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// Close save and close all worlds, and returns the first error.
+func (m *Manager) Close() error {
+	var first error
+	for _, w := range m.All() {
+		if err := w.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
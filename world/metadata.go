@@ -0,0 +1,87 @@
+package world
+
+import "github.com/laushunyu/real/stream"
+
+// metadata indexes of 1.12 entities
+const (
+	// Entity
+	MetaIndexFlags             = 0
+	MetaIndexAir               = 1
+	MetaIndexCustomName        = 2
+	MetaIndexCustomNameVisible = 3
+	MetaIndexSilent            = 4
+	MetaIndexNoGravity         = 5
+
+	// Living
+	MetaIndexHandStates     = 6
+	MetaIndexHealth         = 7
+	MetaIndexPotionColor    = 8
+	MetaIndexPotionAmbient  = 9
+	MetaIndexArrowsInEntity = 10
+
+	// Player
+	MetaIndexAdditionalHearts = 11
+	MetaIndexScore            = 12
+	MetaIndexSkinParts        = 13
+	MetaIndexMainHand         = 14
+	MetaIndexLeftShoulder     = 15
+	MetaIndexRightShoulder    = 16
+)
+
+// entity flags in metadata index 0
+const (
+	EntityFlagOnFire    = 0x01
+	EntityFlagCrouched  = 0x02
+	EntityFlagSprinting = 0x08
+	EntityFlagInvisible = 0x20
+	EntityFlagGlowing   = 0x40
+	EntityFlagElytra    = 0x80
+)
+
+// NewEntityMetadata return metadata with default values of an entity.
+func NewEntityMetadata() *stream.Metadata {
+	m := stream.NewMetadata()
+	m.SetByte(MetaIndexFlags, 0)
+	m.SetVarInt(MetaIndexAir, 300)
+	m.SetString(MetaIndexCustomName, "")
+	m.SetBoolean(MetaIndexCustomNameVisible, false)
+	m.SetBoolean(MetaIndexSilent, false)
+	m.SetBoolean(MetaIndexNoGravity, false)
+	return m
+}
+
+// NewPlayerMetadata return metadata with default values of a player.
+func NewPlayerMetadata() *stream.Metadata {
+	m := NewEntityMetadata()
+	m.SetByte(MetaIndexHandStates, 0)
+	m.SetFloat(MetaIndexHealth, 20)
+	m.SetVarInt(MetaIndexPotionColor, 0)
+	m.SetBoolean(MetaIndexPotionAmbient, false)
+	m.SetVarInt(MetaIndexArrowsInEntity, 0)
+	m.SetFloat(MetaIndexAdditionalHearts, 0)
+	m.SetVarInt(MetaIndexScore, 0)
+	m.SetByte(MetaIndexSkinParts, 0x7f)
+	m.SetByte(MetaIndexMainHand, 1)
+	return m
+}
+
+// SetEntityFlag turn flag in index 0 on or off.
+func SetEntityFlag(m *stream.Metadata, flag byte, on bool) {
+	flags := m.Byte(MetaIndexFlags)
+	if on {
+		flags |= flag
+	} else {
+		flags &^= flag
+	}
+	m.SetByte(MetaIndexFlags, flags)
+}
+
+func HasEntityFlag(m *stream.Metadata, flag byte) bool {
+	return m.Byte(MetaIndexFlags)&flag != 0
+}
+
+// SetCustomName set name shown above entity, empty to hide it.
+func SetCustomName(m *stream.Metadata, name string) {
+	m.SetString(MetaIndexCustomName, name)
+	m.SetBoolean(MetaIndexCustomNameVisible, name != "")
+}
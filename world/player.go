@@ -0,0 +1,72 @@
+package world
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/laushunyu/real/stream"
+)
+
+// slots of player data inventory, others are 0 to 35 from hotbar to the top row
+const (
+	PlayerSlotFeet    = 100
+	PlayerSlotHead    = 103
+	PlayerSlotOffhand = -106
+)
+
+// PlayerData is a player saved in playerdata/<uuid>.dat.
+type PlayerData struct {
+	UUID uuid.UUID
+	// World is name of world player is in, Dimension is used if the world is gone
+	World     string
+	Dimension int32
+
+	X, Y, Z    float64
+	Yaw, Pitch float32
+	OnGround   bool
+
+	GameMode     int32
+	SelectedSlot int32
+	// Inventory is items by slot of player data
+	Inventory map[int8]stream.Slot
+	// state of recipe book
+	RecipeBookOpen, RecipeBookFiltering bool
+
+	Health                 float32
+	Food                   int32
+	Saturation, Exhaustion float32
+	Air, Fire              int16
+	FallDistance           float32
+}
+
+// LoadPlayer return data of player id saved with w, nil if not saved.
+func (w *World) LoadPlayer(id uuid.UUID) (*PlayerData, error) {
+	storage, ok := w.Storage.(PlayerStorage)
+	if !ok {
+		return nil, nil
+	}
+	data, err := storage.LoadPlayer(id)
+	if err != nil {
+		return nil, fmt.Errorf("load player %s of %s: %w", id, w.Name, err)
+	}
+	return data, nil
+}
+
+// SavePlayer save data of player with w.
+func (w *World) SavePlayer(data *PlayerData) error {
+	storage, ok := w.Storage.(PlayerStorage)
+	if !ok {
+		return nil
+	}
+	if err := storage.SavePlayer(data); err != nil {
+		return fmt.Errorf("save player %s of %s: %w", data.UUID, w.Name, err)
+	}
+	return nil
+}
+
+// PlayerStorage is Storage that also keeps players.
+type PlayerStorage interface {
+	// LoadPlayer return nil data and nil error if player is not saved.
+	LoadPlayer(id uuid.UUID) (*PlayerData, error)
+	SavePlayer(data *PlayerData) error
+}
@@ -0,0 +1,35 @@
+package world
+
+// ticks of a day and named times of day
+const (
+	DayLength    = 24000
+	TimeDay      = 1000
+	TimeNoon     = 6000
+	TimeNight    = 13000
+	TimeMidnight = 18000
+)
+
+// TickTime advance world age by a tick,
+// time of day stops while doDaylightCycle is false.
+func (w *World) TickTime() {
+	w.UpdateLevel(func(l *Level) {
+		l.Time++
+		if l.BoolGameRule(GameRuleDoDaylightCycle) {
+			l.DayTime++
+		}
+	})
+}
+
+// Time return world age, time of day and whether time of day is moving.
+func (w *World) Time() (age, dayTime int64, cycle bool) {
+	w.levelMu.RLock()
+	defer w.levelMu.RUnlock()
+	return w.level.Time, w.level.DayTime, w.level.BoolGameRule(GameRuleDoDaylightCycle)
+}
+
+// SetDayTime set time of day, which also counts days passed.
+func (w *World) SetDayTime(dayTime int64) {
+	w.UpdateLevel(func(l *Level) {
+		l.DayTime = dayTime
+	})
+}
@@ -0,0 +1,133 @@
+package world
+
+import (
+	"math/rand"
+)
+
+// Weather is weather of a world now, levels fade in and out between 0 and 1.
+type Weather struct {
+	Raining, Thundering     bool
+	RainLevel, ThunderLevel float32
+}
+
+// weatherFade is how much rain and thunder levels change in a tick.
+const weatherFade = 0.01
+
+// RandomWeatherDuration return ticks of weather set by command without duration.
+func RandomWeatherDuration(r *rand.Rand) int32 {
+	return int32(300+r.Intn(600)) * 20
+}
+
+// Weather return weather of w.
+func (w *World) Weather() Weather {
+	w.levelMu.RLock()
+	defer w.levelMu.RUnlock()
+	return Weather{
+		Raining:      w.level.Raining,
+		Thundering:   w.level.Thundering,
+		RainLevel:    w.rainLevel,
+		ThunderLevel: w.thunderLevel,
+	}
+}
+
+// resetWeatherLevels set levels as weather has been there for long,
+// levels are not saved in level.
+func (w *World) resetWeatherLevels() {
+	w.rainLevel, w.thunderLevel = 0, 0
+	if w.level.Raining {
+		w.rainLevel = 1
+		if w.level.Thundering {
+			w.thunderLevel = 1
+		}
+	}
+}
+
+// TickWeather advance weather by a tick like vanilla, durations count down only if
+// doWeatherCycle is true. It returns weather before and after the tick.
+func (w *World) TickWeather(r *rand.Rand) (before, after Weather) {
+	w.levelMu.Lock()
+	defer w.levelMu.Unlock()
+	l := w.level
+	before = Weather{l.Raining, l.Thundering, w.rainLevel, w.thunderLevel}
+
+	if l.BoolGameRule(GameRuleDoWeatherCycle) {
+		if l.ClearWeatherTime > 0 {
+			// forced clear weather by command
+			l.ClearWeatherTime--
+			l.ThunderTime, l.RainTime = 1, 1
+			if l.Thundering {
+				l.ThunderTime = 0
+			}
+			if l.Raining {
+				l.RainTime = 0
+			}
+			l.Raining, l.Thundering = false, false
+		} else {
+			if l.ThunderTime > 0 {
+				if l.ThunderTime--; l.ThunderTime == 0 {
+					l.Thundering = !l.Thundering
+				}
+			} else if l.Thundering {
+				l.ThunderTime = int32(r.Intn(12000) + 3600)
+			} else {
+				l.ThunderTime = int32(r.Intn(168000) + 12000)
+			}
+
+			if l.RainTime > 0 {
+				if l.RainTime--; l.RainTime == 0 {
+					l.Raining = !l.Raining
+				}
+			} else if l.Raining {
+				l.RainTime = int32(r.Intn(12000) + 12000)
+			} else {
+				l.RainTime = int32(r.Intn(168000) + 12000)
+			}
+		}
+	}
+
+	// thunder only shows while raining
+	w.thunderLevel = fade(w.thunderLevel, l.Thundering && l.Raining)
+	w.rainLevel = fade(w.rainLevel, l.Raining)
+	after = Weather{l.Raining, l.Thundering, w.rainLevel, w.thunderLevel}
+	return before, after
+}
+
+func fade(level float32, on bool) float32 {
+	if on {
+		level += weatherFade
+	} else {
+		level -= weatherFade
+	}
+	switch {
+	case level < 0:
+		return 0
+	case level > 1:
+		return 1
+	}
+	return level
+}
+
+// SetWeather set weather for duration ticks, like /weather command.
+func (w *World) SetWeather(raining, thundering bool, duration int32) {
+	w.UpdateLevel(func(l *Level) {
+		l.Raining, l.Thundering = raining, thundering
+		if raining {
+			l.ClearWeatherTime = 0
+			l.RainTime, l.ThunderTime = duration, duration
+		} else {
+			l.ClearWeatherTime = duration
+			l.RainTime, l.ThunderTime = 0, 0
+		}
+	})
+}
+
+// LoadedChunks return positions of chunks loaded in w.
+func (w *World) LoadedChunks() []ChunkPos {
+	w.chunksMu.Lock()
+	defer w.chunksMu.Unlock()
+	chunks := make([]ChunkPos, 0, len(w.chunks))
+	for pos := range w.chunks {
+		chunks = append(chunks, pos)
+	}
+	return chunks
+}
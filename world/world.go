@@ -0,0 +1,373 @@
+package world
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Generator create new chunks of a world.
+type Generator interface {
+	// Generate return chunk at x, z, the same seed and position always give the same chunk.
+	Generate(x, z int32, seed int64) *Chunk
+}
+
+// GeneratorFunc adapt a function to Generator.
+type GeneratorFunc func(x, z int32, seed int64) *Chunk
+
+func (f GeneratorFunc) Generate(x, z int32, seed int64) *Chunk {
+	return f(x, z, seed)
+}
+
+// Storage loads and saves chunks and level.
+type Storage interface {
+	// LoadChunk return nil chunk and nil error if chunk x, z is not saved.
+	LoadChunk(x, z int32) (*Chunk, error)
+	SaveChunk(c *Chunk) error
+	// LoadLevel return nil level and nil error if level is not saved.
+	LoadLevel() (*Level, error)
+	SaveLevel(l *Level) error
+	Close() error
+}
+
+type World struct {
+	Name string
+	// Dimension decides sky and looks of client, see Dimension constants
+	Dimension int32
+	Generator Generator
+	// Storage is where chunks are loaded from before generating, nil to always generate
+	Storage Storage
+
+	levelMu sync.RWMutex
+	level   *Level
+	// rain and thunder levels fading, guarded by levelMu
+	rainLevel, thunderLevel float32
+
+	mu       sync.RWMutex
+	entities map[int32]Entity
+
+	chunksMu sync.Mutex
+	chunks   map[ChunkPos]*Chunk
+	// broken are chunks failed to load, they are never saved to keep data on disk
+	broken map[ChunkPos]bool
+	// loaded are chunks loaded from storage and not taken yet
+	loaded []*Chunk
+	// unloads counts Unload which forgot chunks, chunks read from storage
+	// before it may be older than the ones it saved
+	unloads uint64
+
+	// lightMu serializes light updates, which may cross chunks
+	lightMu sync.Mutex
+}
+
+func NewWorld(name string, generator Generator) *World {
+	return &World{
+		Name:      name,
+		Generator: generator,
+		level:     NewLevel(name),
+		entities:  make(map[int32]Entity),
+		chunks:    make(map[ChunkPos]*Chunk),
+		broken:    make(map[ChunkPos]bool),
+	}
+}
+
+// LoadLevel replace level with the one in storage if saved.
+func (w *World) LoadLevel() error {
+	if w.Storage == nil {
+		return nil
+	}
+	level, err := w.Storage.LoadLevel()
+	if err != nil || level == nil {
+		return err
+	}
+	w.levelMu.Lock()
+	w.level = level
+	w.resetWeatherLevels()
+	w.levelMu.Unlock()
+	return nil
+}
+
+// Level return a copy of level.
+func (w *World) Level() *Level {
+	w.levelMu.RLock()
+	defer w.levelMu.RUnlock()
+	return w.level.Clone()
+}
+
+// Seed return seed of level.
+func (w *World) Seed() int64 {
+	w.levelMu.RLock()
+	defer w.levelMu.RUnlock()
+	return w.level.Seed
+}
+
+// UpdateLevel call fn to change level.
+func (w *World) UpdateLevel(fn func(l *Level)) {
+	w.levelMu.Lock()
+	defer w.levelMu.Unlock()
+	fn(w.level)
+}
+
+// Save write level and chunks changed since last save to storage.
+func (w *World) Save() error {
+	if w.Storage == nil {
+		return nil
+	}
+
+	level := w.Level()
+	level.LastPlayed = time.Now().UnixNano() / int64(time.Millisecond)
+	if err := w.Storage.SaveLevel(level); err != nil {
+		return fmt.Errorf("save level of %s: %w", w.Name, err)
+	}
+
+	w.chunksMu.Lock()
+	chunks := make([]*Chunk, 0, len(w.chunks))
+	for pos, chunk := range w.chunks {
+		if w.broken[pos] {
+			continue
+		}
+		chunks = append(chunks, chunk)
+	}
+	w.chunksMu.Unlock()
+
+	var saved int
+	for _, chunk := range chunks {
+		if !chunk.TakeUnsaved() {
+			continue
+		}
+		if err := w.Storage.SaveChunk(chunk); err != nil {
+			// try again next save
+			chunk.MarkUnsaved()
+			return fmt.Errorf("save chunk (%d, %d) of %s: %w", chunk.X, chunk.Z, w.Name, err)
+		}
+		saved++
+	}
+	log.Debugf("saved %d chunks of %s", saved, w.Name)
+	return nil
+}
+
+// Close save world and close its storage.
+func (w *World) Close() error {
+	if w.Storage == nil {
+		return nil
+	}
+	if err := w.Save(); err != nil {
+		w.Storage.Close()
+		return err
+	}
+	return w.Storage.Close()
+}
+
+// Chunk return chunk at x, z, load or generate it if not exists.
+func (w *World) Chunk(x, z int32) *Chunk {
+	chunk := w.chunk(x, z)
+	if atomic.LoadInt32(&chunk.lit) == 0 {
+		w.lightChunk(chunk)
+	}
+	return chunk
+}
+
+// loadedChunk return chunk at x, z if it is loaded, nil otherwise.
+func (w *World) loadedChunk(x, z int32) *Chunk {
+	w.chunksMu.Lock()
+	defer w.chunksMu.Unlock()
+	return w.chunks[ChunkPos{X: x, Z: z}]
+}
+
+// chunk return chunk at x, z, it may not be lit yet.
+// Chunks are loaded or generated without holding chunksMu, so lookups of other chunks
+// don't wait for them, the first one put in map wins if a chunk is loaded twice.
+func (w *World) chunk(x, z int32) *Chunk {
+	pos := ChunkPos{X: x, Z: z}
+	w.chunksMu.Lock()
+	chunk, unloads := w.chunks[pos], w.unloads
+	w.chunksMu.Unlock()
+	if chunk != nil {
+		return chunk
+	}
+
+	broken := false
+	if w.Storage != nil {
+		var err error
+		chunk, err = w.Storage.LoadChunk(x, z)
+		if err != nil {
+			log.WithError(err).Errorf("failed to load chunk (%d, %d) of %s, generate it and never save it", x, z, w.Name)
+			broken = true
+		}
+	}
+	fromStorage := chunk != nil
+	if fromStorage {
+		chunk.TakeUnsaved()
+		// saved with light
+		atomic.StoreInt32(&chunk.lit, 1)
+	} else {
+		chunk = w.Generator.Generate(x, z, w.Seed())
+	}
+	// blocks from storage or generator are not modifications
+	chunk.TakeModified()
+
+	w.chunksMu.Lock()
+	if loaded, ok := w.chunks[pos]; ok {
+		w.chunksMu.Unlock()
+		return loaded
+	}
+	if w.unloads != unloads {
+		// the chunk may be saved and forgotten while reading, read it again
+		w.chunksMu.Unlock()
+		return w.chunk(x, z)
+	}
+	defer w.chunksMu.Unlock()
+	if broken {
+		w.broken[pos] = true
+	}
+	switch {
+	case fromStorage:
+		w.loaded = append(w.loaded, chunk)
+	case !w.broken[pos]:
+		// generated chunk is kept unsaved so it will be saved
+		chunk.MarkUnsaved()
+	}
+	w.chunks[pos] = chunk
+	return chunk
+}
+
+// Unload save chunks for which keep reports false and forget them, so only chunks in use
+// stay in memory. keep is called with chunksMu held. Chunks not lit yet, changed while
+// saving or failed to save are kept, it returns the number of chunks forgotten and the
+// first error of saving. Nothing is forgotten without storage to load it back.
+func (w *World) Unload(keep func(pos ChunkPos) bool) (int, error) {
+	if w.Storage == nil {
+		return 0, nil
+	}
+
+	w.chunksMu.Lock()
+	var chunks []*Chunk
+	broken := make(map[ChunkPos]bool)
+	for pos, chunk := range w.chunks {
+		if !keep(pos) && atomic.LoadInt32(&chunk.lit) != 0 {
+			chunks = append(chunks, chunk)
+			broken[pos] = w.broken[pos]
+		}
+	}
+	w.chunksMu.Unlock()
+
+	var first error
+	saved := make([]*Chunk, 0, len(chunks))
+	for _, chunk := range chunks {
+		pos := ChunkPos{X: chunk.X, Z: chunk.Z}
+		if !broken[pos] && chunk.TakeUnsaved() {
+			if err := w.Storage.SaveChunk(chunk); err != nil {
+				chunk.MarkUnsaved()
+				if first == nil {
+					first = fmt.Errorf("save chunk (%d, %d) of %s: %w", chunk.X, chunk.Z, w.Name, err)
+				}
+				continue
+			}
+		}
+		saved = append(saved, chunk)
+	}
+
+	// light updates may change chunks, they are excluded while forgetting
+	w.lightMu.Lock()
+	defer w.lightMu.Unlock()
+	w.chunksMu.Lock()
+	defer w.chunksMu.Unlock()
+	n := 0
+	for _, chunk := range saved {
+		pos := ChunkPos{X: chunk.X, Z: chunk.Z}
+		if w.chunks[pos] != chunk || chunk.Unsaved() && !w.broken[pos] {
+			continue
+		}
+		delete(w.chunks, pos)
+		delete(w.broken, pos)
+		n++
+	}
+	if n > 0 {
+		w.unloads++
+	}
+	return n, first
+}
+
+// TakeLoaded return chunks loaded from storage since last call,
+// their block entities may need to run like furnaces burning.
+func (w *World) TakeLoaded() []*Chunk {
+	w.chunksMu.Lock()
+	defer w.chunksMu.Unlock()
+	loaded := w.loaded
+	w.loaded = nil
+	return loaded
+}
+
+// EachModified call fn with loaded chunks whose sections are modified since last call,
+// sections is bitmask of them.
+func (w *World) EachModified(fn func(c *Chunk, sections uint16)) {
+	w.chunksMu.Lock()
+	chunks := make([]*Chunk, 0, len(w.chunks))
+	for _, chunk := range w.chunks {
+		chunks = append(chunks, chunk)
+	}
+	w.chunksMu.Unlock()
+
+	for _, chunk := range chunks {
+		if sections := chunk.TakeModified(); sections != 0 {
+			fn(chunk, sections)
+		}
+	}
+}
+
+// Block return block state at world coordinate.
+func (w *World) Block(x, y, z int) BlockState {
+	if y < 0 || y >= SectionCount*16 {
+		return Air
+	}
+	return w.Chunk(int32(x>>4), int32(z>>4)).Block(x&0xF, y, z&0xF)
+}
+
+// SetBlock set block state at world coordinate, and update light around it.
+func (w *World) SetBlock(x, y, z int, state BlockState) {
+	if y < 0 || y >= SectionCount*16 {
+		return
+	}
+	chunk := w.Chunk(int32(x>>4), int32(z>>4))
+	old := chunk.Block(x&0xF, y, z&0xF)
+	if old == state {
+		return
+	}
+	chunk.SetBlock(x&0xF, y, z&0xF, state)
+	if blockOpacity(old) != blockOpacity(state) || blockEmission(old) != blockEmission(state) {
+		w.updateLight(x, y, z)
+	}
+}
+
+func (w *World) AddEntity(e Entity) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.entities[e.ID()] = e
+}
+
+func (w *World) RemoveEntity(e Entity) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.entities, e.ID())
+}
+
+// Entity return entity by id, nil if not found.
+func (w *World) Entity(id int32) Entity {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.entities[id]
+}
+
+// Entities return a snapshot of entities in world.
+func (w *World) Entities() []Entity {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	entities := make([]Entity, 0, len(w.entities))
+	for _, e := range w.entities {
+		entities = append(entities, e)
+	}
+	return entities
+}